@@ -0,0 +1,168 @@
+// Command openai-sweep deletes the objects left behind in an organization by
+// failed acceptance test runs, using the same sweepers as `go test -sweep`.
+//
+// It authenticates with the admin key in OPENAI_ADMIN_KEY and sends requests to
+// OPENAI_BASE_URL, which defaults to https://api.openai.com/v1.
+//
+// Usage:
+//
+//	openai-sweep [-prefix tf-] [-type openai_project] [-dry-run] [-min-age 1h] [-include-unknown-age] [-json]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+	"github.com/jianyuan/terraform-provider-openai/internal/sweepers"
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/option"
+)
+
+// version is set by the build, e.g. -ldflags "-X main.version=1.0.0".
+var version = "dev"
+
+// stringsFlag is a flag that may be repeated or hold a comma separated list.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(value string) error {
+	for v := range strings.SplitSeq(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*f = append(*f, v)
+		}
+	}
+	return nil
+}
+
+type report struct {
+	DryRun   bool            `json:"dry_run"`
+	Sweepers []sweeperReport `json:"sweepers"`
+}
+
+type sweeperReport struct {
+	Name    string         `json:"name"`
+	Error   string         `json:"error,omitempty"`
+	Results []sweep.Result `json:"results"`
+}
+
+func main() {
+	log.SetFlags(0)
+
+	if err := run(); err != nil {
+		log.Fatalf("[ERROR] %s", err)
+	}
+}
+
+func run() error {
+	var prefixes, types stringsFlag
+	var opts sweep.Options
+	var jsonReport, list bool
+
+	flag.Var(&prefixes, "prefix", "sweep objects whose name starts with this prefix, which may hold the wildcards of path.Match; may be repeated (default \"tf-\")")
+	flag.Var(&types, "type", "sweep only this resource type, e.g. openai_project, and the types it depends on; may be repeated (default all)")
+	flag.BoolVar(&opts.DryRun, "dry-run", false, "only report the objects that would be deleted")
+	flag.DurationVar(&opts.MinAge, "min-age", time.Hour, "skip objects created less recently than this, or whose creation time is unknown; 0 sweeps everything")
+	flag.BoolVar(&opts.IncludeUnknownAge, "include-unknown-age", false, "sweep the objects whose creation time is unknown, such as roles, role assignments and spend alerts, by name only despite -min-age")
+	flag.IntVar(&opts.Concurrency, "concurrency", sweep.DefaultConcurrency, "maximum number of objects deleted at once")
+	flag.BoolVar(&jsonReport, "json", false, "write a JSON report of every object to stdout")
+	flag.BoolVar(&list, "list", false, "list the resource types and exit")
+	flag.Parse()
+
+	all := sweepers.All(func() string {
+		return os.Getenv("OPENAI_ADMIN_KEY")
+	})
+
+	if list {
+		for _, sweeper := range all {
+			fmt.Println(sweeper.Name)
+		}
+		return nil
+	}
+
+	if len(prefixes) == 0 {
+		prefixes = sweep.DefaultPrefixes
+	}
+	match, err := sweep.PrefixMatcher(prefixes)
+	if err != nil {
+		return err
+	}
+
+	selected, err := sweep.Resolve(all, types)
+	if err != nil {
+		return err
+	}
+
+	client, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	rep := report{DryRun: opts.DryRun}
+	var errs []error
+	for _, sweeper := range selected {
+		log.Printf("[INFO] Running sweeper %s", sweeper.Name)
+
+		sr := sweeperReport{Name: sweeper.Name, Results: []sweep.Result{}}
+
+		sweepables, err := sweeper.F(ctx, client, match)
+		if err != nil {
+			err = fmt.Errorf("failed to collect %q: %w", sweeper.Name, err)
+		} else {
+			sr.Results, err = sweep.Sweep(ctx, sweepables, opts)
+			if err != nil {
+				err = fmt.Errorf("failed to sweep %q: %w", sweeper.Name, err)
+			}
+		}
+		if err != nil {
+			sr.Error = err.Error()
+			errs = append(errs, err)
+		}
+
+		rep.Sweepers = append(rep.Sweepers, sr)
+	}
+
+	if jsonReport {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(rep); err != nil {
+			return err
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func newClient() (*openai.Client, error) {
+	adminKey := os.Getenv("OPENAI_ADMIN_KEY")
+	if adminKey == "" {
+		return nil, errors.New("OPENAI_ADMIN_KEY must be set")
+	}
+
+	baseUrl := os.Getenv("OPENAI_BASE_URL")
+	if baseUrl == "" {
+		baseUrl = "https://api.openai.com/v1"
+	}
+
+	return new(openai.NewClient(
+		option.WithBaseURL(baseUrl),
+		option.WithAdminAPIKey(adminKey),
+		option.WithHeader("User-Agent", fmt.Sprintf("openai-sweep/%s", version)),
+		option.WithRequestTimeout(60*time.Second),
+		option.WithMaxRetries(5),
+	)), nil
+}
@@ -0,0 +1,8 @@
+data "openai_permissions" "organization" {
+  resource_type = "api.organization"
+}
+
+data "openai_permissions" "project" {
+  resource_type = "api.project"
+  project_id    = "proj_000000000000000000000000"
+}
@@ -0,0 +1,25 @@
+locals {
+  permissions = ["api.groups.read", "api.groups.reed"]
+
+  # Checked against the built-in list: ["api.groups.reed"]
+  builtin_invalid_permissions = provider::openai::validate_permissions("api.organization", local.permissions)
+
+  # Checked against the permissions reported by the API
+  invalid_permissions = provider::openai::validate_permissions("api.organization", local.permissions, data.openai_permissions.organization.permissions)
+}
+
+data "openai_permissions" "organization" {
+  resource_type = "api.organization"
+}
+
+resource "openai_organization_role" "example" {
+  name        = "groups-reader"
+  permissions = local.permissions
+
+  lifecycle {
+    precondition {
+      condition     = length(local.invalid_permissions) == 0
+      error_message = "Invalid permissions: ${join(", ", local.invalid_permissions)}"
+    }
+  }
+}
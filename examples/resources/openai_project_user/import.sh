@@ -3,3 +3,6 @@ terraform import openai_project_user.example <project_id>/<user_id>
 
 # Example
 terraform import openai_project_user.example proj_000000000000000000000000/user-000000000000000000000000
+
+# The parts can also be separated with a colon
+terraform import openai_project_user.example proj_000000000000000000000000:user-000000000000000000000000
@@ -19,12 +19,19 @@ var (
 	TestBaseUrl  = os.Getenv("OPENAI_BASE_URL")
 	TestAdminKey = os.Getenv("OPENAI_ADMIN_KEY")
 	TestUserId   = os.Getenv("OPENAI_TEST_USER_ID")
-	TestGroupId  string
 
 	SharedClient *openai.Client
 )
 
 func init() {
+	// In mock mode the mock server is not running yet, so TestMain configures
+	// the package once it has started.
+	if !MockEnabled {
+		configure()
+	}
+}
+
+func configure() {
 	if TestBaseUrl == "" {
 		TestBaseUrl = "https://api.openai.com/v1"
 	}
@@ -37,17 +44,18 @@ func init() {
 		option.WithMaxRetries(5),
 		option.WithDebugLog(tflog.StandardLogger(context.Background())),
 	))
-
-	TestGroupId = ensureTestGroupId(context.Background())
 }
 
 func PreCheck(t *testing.T) {
-	if TestAdminKey == "" {
-		t.Fatal("OPENAI_ADMIN_KEY must be set for acceptance tests")
+	if Replaying() {
+		if _, ok := cassetteTests.Load(t); !ok {
+			t.Skip("Only tests using a cassette are run when replaying cassettes")
+		}
+		return
 	}
 
-	if TestUserId == "" {
-		t.Fatal("OPENAI_TEST_USER_ID must be set for acceptance tests")
+	if TestAdminKey == "" {
+		t.Fatal("OPENAI_ADMIN_KEY must be set for acceptance tests")
 	}
 }
 
@@ -58,26 +66,3 @@ func PreCheck(t *testing.T) {
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"openai": providerserver.NewProtocol6WithError(provider.New("test")()),
 }
-
-func ensureTestGroupId(ctx context.Context) string {
-	params := openai.AdminOrganizationGroupListParams{
-		Limit: openai.Int(100),
-	}
-
-	iter := SharedClient.Admin.Organization.Groups.ListAutoPaging(ctx, params)
-	for iter.Next() {
-		group := iter.Current()
-		if group.Name == "acc-tf-group" {
-			return group.ID
-		}
-	}
-
-	group, err := SharedClient.Admin.Organization.Groups.New(ctx, openai.AdminOrganizationGroupNewParams{
-		Name: "acc-tf-group",
-	})
-	if err != nil {
-		panic(err)
-	}
-
-	return group.ID
-}
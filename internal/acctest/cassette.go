@@ -0,0 +1,388 @@
+package acctest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/jianyuan/terraform-provider-openai/internal/provider"
+	"github.com/openai/openai-go/v3/option"
+)
+
+// CassetteMode controls how tests using a Cassette talk to the API. Set
+// OPENAI_ACC_CASSETTES to "record" to record the API interactions of each
+// test to testdata/cassettes, or to "replay" to serve them back without
+// network access or credentials. Otherwise, requests are sent to the API.
+var CassetteMode = os.Getenv("OPENAI_ACC_CASSETTES")
+
+const (
+	CassetteModeRecord = "record"
+	CassetteModeReplay = "replay"
+)
+
+// CassetteDir is the directory, relative to the test package, in which
+// cassettes are stored.
+const CassetteDir = "testdata/cassettes"
+
+// Replaying reports whether tests replay recorded cassettes.
+func Replaying() bool {
+	return CassetteMode == CassetteModeReplay
+}
+
+type cassetteFile struct {
+	// Random holds the values returned by RandomWithPrefix, in order.
+	Random       []string      `json:"random"`
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string `json:"method"`
+	Url    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// cassetteTests holds the tests using a cassette.
+var cassetteTests sync.Map
+
+// recordedHeaders are the response headers stored in cassettes.
+var recordedHeaders = []string{"Content-Type", "Retry-After", "X-Request-Id"}
+
+// Cassette records or replays the API interactions of a single test.
+type Cassette struct {
+	t    *testing.T
+	path string
+	mode string
+
+	mu     sync.Mutex
+	file   cassetteFile
+	random int
+	// pos is the index of the interaction following the last replayed change.
+	pos int
+	// reads counts the reads of each request key replayed since then.
+	reads map[string]int
+}
+
+// NewCassette returns the cassette of the test. In replay mode, the test fails
+// if no cassette has been recorded for it, so that a missing cassette is not
+// mistaken for a passing test.
+func NewCassette(t *testing.T) *Cassette {
+	t.Helper()
+
+	c := &Cassette{
+		t:     t,
+		path:  filepath.Join(CassetteDir, cassetteName(t)+".json"),
+		mode:  CassetteMode,
+		reads: make(map[string]int),
+	}
+
+	cassetteTests.Store(t, c)
+	t.Cleanup(func() { cassetteTests.Delete(t) })
+
+	switch c.mode {
+	case CassetteModeRecord:
+		t.Cleanup(func() {
+			if t.Failed() {
+				return
+			}
+			if err := c.save(); err != nil {
+				t.Errorf("Unable to save cassette: %s", err)
+			}
+		})
+	case CassetteModeReplay:
+		file, err := readCassette(c.path)
+		if errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("No cassette recorded at %s, record it with OPENAI_ACC_CASSETTES=%s", c.path, CassetteModeRecord)
+		} else if err != nil {
+			t.Fatalf("Unable to read cassette: %s", err)
+		}
+		c.load(file)
+
+		// The requests never reach the API, but the provider still requires
+		// a well-formed admin key.
+		t.Setenv("OPENAI_ADMIN_KEY", "sk-admin-replay")
+	case "":
+	default:
+		t.Fatalf("OPENAI_ACC_CASSETTES must be %q or %q, got %q", CassetteModeRecord, CassetteModeReplay, c.mode)
+	}
+
+	return c
+}
+
+func readCassette(path string) (cassetteFile, error) {
+	var file cassetteFile
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return file, err
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return file, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+
+	return file, nil
+}
+
+func (c *Cassette) load(file cassetteFile) {
+	c.file = file
+	c.pos = 0
+	clear(c.reads)
+}
+
+// RandomWithPrefix is like acctest.RandomWithPrefix, but returns the recorded
+// values when replaying, so that the configuration matches the cassette.
+func (c *Cassette) RandomWithPrefix(prefix string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.mode == CassetteModeReplay {
+		if c.random >= len(c.file.Random) {
+			c.t.Fatalf("Cassette %s has no more recorded random values", c.path)
+		}
+		v := c.file.Random[c.random]
+		c.random++
+		return v
+	}
+
+	v := sdkacctest.RandomWithPrefix(prefix)
+	c.file.Random = append(c.file.Random, v)
+	return v
+}
+
+// ProviderFactories returns provider factories whose API clients record or
+// replay the cassette.
+func (c *Cassette) ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	if c.mode == "" {
+		return TestAccProtoV6ProviderFactories
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"openai": providerserver.NewProtocol6WithError(provider.NewWithClientOptions("test", option.WithMiddleware(c.Middleware))()),
+	}
+}
+
+// Middleware implements option.Middleware.
+func (c *Cassette) Middleware(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+	switch c.mode {
+	case CassetteModeRecord:
+		return c.record(req, next)
+	case CassetteModeReplay:
+		return c.replay(req)
+	default:
+		return next(req)
+	}
+}
+
+func (c *Cassette) record(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+	var reqBody []byte
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		reqBody, err = io.ReadAll(body)
+		_ = body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := next(req)
+	if err != nil {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	headers := make(map[string]string)
+	for _, key := range recordedHeaders {
+		if v := resp.Header.Get(key); v != "" {
+			headers[key] = v
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.file.Interactions = append(c.file.Interactions, interaction{
+		Request: recordedRequest{
+			Method: req.Method,
+			Url:    scrub(requestUrl(req)),
+			Body:   scrub(string(reqBody)),
+		},
+		Response: recordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       scrub(string(respBody)),
+		},
+	})
+
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	key := requestKey(req.Method, scrub(requestUrl(req)))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i, ok := c.next(req.Method, key)
+	if !ok {
+		return nil, fmt.Errorf("cassette %s has no recorded response for %s", c.path, key)
+	}
+
+	header := make(http.Header)
+	for key, value := range i.Response.Headers {
+		header.Set(key, value)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+		StatusCode:    i.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+		ContentLength: int64(len(i.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// next returns the recorded interaction answering a request. Changes are
+// replayed in the recorded order. Reads are answered with the matching reads
+// recorded since the last replayed change, in order and then the last of them
+// again, or with the last matching read before it if there is none, so that a
+// replay does not depend on how often Terraform reads.
+func (c *Cassette) next(method, key string) (interaction, bool) {
+	interactions := c.file.Interactions
+	isRead := func(i interaction) bool {
+		return i.Request.Method == http.MethodGet
+	}
+	matches := func(i interaction) bool {
+		return requestKey(i.Request.Method, i.Request.Url) == key
+	}
+
+	if method != http.MethodGet {
+		for j := c.pos; j < len(interactions); j++ {
+			if isRead(interactions[j]) {
+				continue
+			} else if !matches(interactions[j]) {
+				return interaction{}, false
+			}
+			c.pos = j + 1
+			clear(c.reads)
+			return interactions[j], true
+		}
+		return interaction{}, false
+	}
+
+	var reads []interaction
+	for _, i := range interactions[c.pos:] {
+		if !isRead(i) {
+			break
+		} else if matches(i) {
+			reads = append(reads, i)
+		}
+	}
+	if len(reads) > 0 {
+		n := c.reads[key]
+		c.reads[key]++
+		return reads[min(n, len(reads)-1)], true
+	}
+
+	for j := c.pos - 1; j >= 0; j-- {
+		if isRead(interactions[j]) && matches(interactions[j]) {
+			return interactions[j], true
+		}
+	}
+	return interaction{}, false
+}
+
+func (c *Cassette) save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// requestUrl returns the path and query of req relative to the API base URL,
+// so that cassettes do not depend on the base URL they were recorded with.
+func requestUrl(req *http.Request) string {
+	u := req.URL.EscapedPath()
+	if i := strings.Index(u, "/organization/"); i >= 0 {
+		u = u[i:]
+	} else if i := strings.Index(u, "/projects/"); i >= 0 {
+		u = u[i:]
+	}
+	if req.URL.RawQuery != "" {
+		u += "?" + req.URL.RawQuery
+	}
+	return u
+}
+
+func requestKey(method, url string) string {
+	return method + " " + url
+}
+
+var (
+	adminKeyPattern = regexp.MustCompile(`sk-admin-[A-Za-z0-9_-]+`)
+	emailPattern    = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+)
+
+// scrub redacts admin API keys and email addresses. Email addresses are
+// replaced deterministically, so that the same address is redacted the same
+// way throughout a cassette. Addresses at example.com are generated by the
+// tests and kept as is.
+func scrub(s string) string {
+	s = adminKeyPattern.ReplaceAllString(s, "sk-admin-REDACTED")
+	return emailPattern.ReplaceAllStringFunc(s, func(email string) string {
+		if strings.HasSuffix(strings.ToLower(email), "@example.com") {
+			return email
+		}
+		sum := sha256.Sum256([]byte(strings.ToLower(email)))
+		return "redacted-" + hex.EncodeToString(sum[:4]) + "@example.com"
+	})
+}
+
+func cassetteName(t *testing.T) string {
+	return strings.NewReplacer("/", "__", " ", "_").Replace(t.Name())
+}
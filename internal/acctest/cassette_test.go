@@ -0,0 +1,163 @@
+package acctest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openai/openai-go/v3/option"
+)
+
+func TestScrub(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{in: `{"value":"sk-admin-abc_DEF-123"}`, want: `{"value":"sk-admin-REDACTED"}`},
+		{in: `{"email":"jane@example.com"}`, want: `{"email":"jane@example.com"}`},
+		{in: `{"email":"Jane.Doe@corp.io"}`, want: `{"email":"redacted-982f3dfa@example.com"}`},
+		{in: `/organization/users?limit=100`, want: `/organization/users?limit=100`},
+	}
+
+	for _, tc := range testCases {
+		if got := scrub(tc.in); got != tc.want {
+			t.Errorf("scrub(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+
+	if scrub("Jane.Doe@corp.io") != scrub("jane.doe@corp.io") {
+		t.Error("email addresses are not redacted deterministically")
+	}
+}
+
+func TestCassette(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "secret")
+		switch r.Method {
+		case http.MethodPost:
+			_, _ = w.Write([]byte(`{"id":"proj_1","value":"sk-admin-secret"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"proj_1","owner":"owner@corp.io"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	do := func(c *Cassette, method, p string) string {
+		req, err := http.NewRequestWithContext(t.Context(), method, srv.URL+"/v1"+p, strings.NewReader(`{"name":"test"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := c.Middleware(req, option.MiddlewareNext(http.DefaultClient.Do))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := resp.Header.Get("Set-Cookie"); got != "" && c.mode == CassetteModeReplay {
+			t.Errorf("replayed Set-Cookie header %q", got)
+		}
+		return string(body)
+	}
+
+	recorder := &Cassette{t: t, path: path, mode: CassetteModeRecord, reads: map[string]int{}}
+	name := recorder.RandomWithPrefix("tf-project")
+	if got := do(recorder, http.MethodPost, "/organization/projects"); got != `{"id":"proj_1","value":"sk-admin-secret"}` {
+		t.Errorf("got recorded response %s", got)
+	}
+	do(recorder, http.MethodGet, "/organization/projects/proj_1")
+	if err := recorder.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	player := &Cassette{t: t, path: path, mode: CassetteModeReplay, reads: map[string]int{}}
+	data, err := readCassette(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	player.load(data)
+
+	if got := player.RandomWithPrefix("tf-project"); got != name {
+		t.Errorf("got random value %q, want %q", got, name)
+	}
+	if got := do(player, http.MethodPost, "/organization/projects"); got != `{"id":"proj_1","value":"sk-admin-REDACTED"}` {
+		t.Errorf("got replayed response %s", got)
+	}
+	for range 2 {
+		if got := do(player, http.MethodGet, "/organization/projects/proj_1"); !strings.HasPrefix(got, `{"id":"proj_1","owner":"redacted-`) {
+			t.Errorf("got replayed response %s", got)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("got %d requests to the server, want 2", requests)
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, srv.URL+"/v1/organization/projects", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := player.Middleware(req, nil); err == nil {
+		t.Error("expected an error for a request without a recorded response")
+	}
+}
+
+func TestCassetteReplayOrder(t *testing.T) {
+	get := func(url, body string) interaction {
+		return interaction{Request: recordedRequest{Method: http.MethodGet, Url: url}, Response: recordedResponse{StatusCode: http.StatusOK, Body: body}}
+	}
+	post := func(url, body string) interaction {
+		return interaction{Request: recordedRequest{Method: http.MethodPost, Url: url}, Response: recordedResponse{StatusCode: http.StatusOK, Body: body}}
+	}
+
+	c := &Cassette{t: t, mode: CassetteModeReplay, reads: map[string]int{}}
+	c.load(cassetteFile{Interactions: []interaction{
+		post("/organization/projects", "created"),
+		get("/organization/projects/proj_1", "missing"),
+		get("/organization/projects/proj_1", "v1"),
+		post("/organization/projects/proj_1", "updated"),
+		get("/organization/projects/proj_1", "v2"),
+		post("/organization/projects/proj_1/archive", "archived"),
+	}})
+
+	do := func(method, url string) string {
+		t.Helper()
+		i, ok := c.next(method, requestKey(method, url))
+		if !ok {
+			t.Fatalf("no recorded response for %s %s", method, url)
+		}
+		return i.Response.Body
+	}
+
+	for _, tc := range []struct {
+		method, url, want string
+	}{
+		{http.MethodPost, "/organization/projects", "created"},
+		{http.MethodGet, "/organization/projects/proj_1", "missing"},
+		{http.MethodGet, "/organization/projects/proj_1", "v1"},
+		{http.MethodGet, "/organization/projects/proj_1", "v1"},
+		{http.MethodPost, "/organization/projects/proj_1", "updated"},
+		{http.MethodGet, "/organization/projects/proj_1", "v2"},
+		{http.MethodGet, "/organization/projects/proj_1", "v2"},
+		{http.MethodPost, "/organization/projects/proj_1/archive", "archived"},
+		// Nothing was read after the archive, so the last read is served.
+		{http.MethodGet, "/organization/projects/proj_1", "v2"},
+	} {
+		if got := do(tc.method, tc.url); got != tc.want {
+			t.Errorf("%s %s = %q, want %q", tc.method, tc.url, got, tc.want)
+		}
+	}
+
+	if _, ok := c.next(http.MethodPost, requestKey(http.MethodPost, "/organization/projects")); ok {
+		t.Error("replayed a change that was not recorded next")
+	}
+}
@@ -0,0 +1,104 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// FaultRule describes a fault injected into the mock server for requests
+// matching Method and the Path regular expression. See
+// internal/mockserver/faults.ts for the supported fault types.
+type FaultRule struct {
+	Method string `json:"method,omitempty"`
+	Path   string `json:"path"`
+	// Count is the number of matching requests the fault applies to. Zero
+	// applies the fault until the test ends.
+	Count int   `json:"count,omitempty"`
+	Fault Fault `json:"fault"`
+}
+
+type Fault struct {
+	Type           string `json:"type"`
+	Status         int    `json:"status,omitempty"`
+	RetryAfter     *int   `json:"retry_after,omitempty"`
+	DelayMs        int    `json:"delay_ms,omitempty"`
+	HiddenRequests int    `json:"hidden_requests,omitempty"`
+}
+
+func controlUrl(p string) string {
+	return strings.TrimSuffix(TestBaseUrl, "/") + "/__control" + p
+}
+
+// PreCheckMockServer skips the test unless the provider is tested against the
+// mock server, which exposes the fault injection control API.
+func PreCheckMockServer(t *testing.T) {
+	if !MockEnabled {
+		t.Skip("Mock server tests are skipped unless OPENAI_ACC_MOCK=1")
+	}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, controlUrl("/faults"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Skipf("Mock server control API is not reachable: %s", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Skipf("Mock server control API is not available, got status %d", resp.StatusCode)
+	}
+}
+
+// InjectFault injects a fault into the mock server, removing it once the test
+// completes.
+func InjectFault(t *testing.T, rule FaultRule) {
+	body, err := json.Marshal(rule)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var created struct {
+		Id int `json:"id"`
+	}
+	if err := doControlRequest(t.Context(), http.MethodPost, "/faults", body, &created); err != nil {
+		t.Fatalf("Unable to inject fault: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := doControlRequest(context.Background(), http.MethodDelete, fmt.Sprintf("/faults/%d", created.Id), nil, nil); err != nil {
+			t.Errorf("Unable to remove fault: %s", err)
+		}
+	})
+}
+
+func doControlRequest(ctx context.Context, method, p string, body []byte, dst any) error {
+	req, err := http.NewRequestWithContext(ctx, method, controlUrl(p), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	if dst != nil {
+		return json.NewDecoder(resp.Body).Decode(dst)
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/openai/openai-go/v3"
+)
+
+// TestGroupName is the name of the group returned by Group.
+const TestGroupName = "acc-tf-group"
+
+// fixture is provisioned on first use and cached for the rest of the run. If
+// provisioning fails, every test that requires the fixture is skipped.
+type fixture[T any] struct {
+	once  sync.Once
+	value T
+	err   error
+}
+
+func (f *fixture[T]) get(t *testing.T, name string, provision func(ctx context.Context) (T, error)) T {
+	t.Helper()
+	preCheckFixture(t)
+
+	f.once.Do(func() {
+		f.value, f.err = provision(context.Background())
+	})
+	if f.err != nil {
+		t.Skipf("Unable to provision the %s fixture: %s", name, f.err)
+	}
+
+	return f.value
+}
+
+var (
+	cleanupsMu sync.Mutex
+	cleanups   []func(ctx context.Context) error
+)
+
+// registerRunCleanup registers a function to tear down a fixture shared by
+// the whole run. It is called by TestMain once all tests have completed.
+func registerRunCleanup(fn func(ctx context.Context) error) {
+	cleanupsMu.Lock()
+	defer cleanupsMu.Unlock()
+	cleanups = append(cleanups, fn)
+}
+
+func runCleanups(ctx context.Context) {
+	cleanupsMu.Lock()
+	defer cleanupsMu.Unlock()
+
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		if err := cleanups[i](ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "Unable to clean up a test fixture: %s\n", err)
+		}
+	}
+	cleanups = nil
+}
+
+// preCheckFixture skips the test before any fixture is provisioned if
+// acceptance tests are not enabled, as resource.Test would, or if cassettes
+// are replayed, as fixtures require API access.
+func preCheckFixture(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Acceptance tests skipped unless env 'TF_ACC' set")
+	}
+	if Replaying() {
+		t.Skip("Fixtures are not available when replaying cassettes")
+	}
+	PreCheck(t)
+}
+
+var groupFixture fixture[*openai.Group]
+
+// Group returns the `acc-tf-group` group, creating it if it does not exist.
+// A group created by the run is deleted once the run completes.
+func Group(t *testing.T) *openai.Group {
+	t.Helper()
+
+	return groupFixture.get(t, "group", func(ctx context.Context) (*openai.Group, error) {
+		params := openai.AdminOrganizationGroupListParams{
+			Limit: openai.Int(100),
+		}
+
+		iter := SharedClient.Admin.Organization.Groups.ListAutoPaging(ctx, params)
+		for iter.Next() {
+			group := iter.Current()
+			if group.Name == TestGroupName {
+				return &group, nil
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+
+		group, err := SharedClient.Admin.Organization.Groups.New(ctx, openai.AdminOrganizationGroupNewParams{
+			Name: TestGroupName,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		registerRunCleanup(func(ctx context.Context) error {
+			_, err := SharedClient.Admin.Organization.Groups.Delete(ctx, group.ID)
+			return err
+		})
+
+		return group, nil
+	})
+}
+
+var userFixture fixture[*openai.OrganizationUser]
+
+// User returns the organization user identified by OPENAI_TEST_USER_ID. Users
+// cannot be created through the API, so tests requiring a user are skipped if
+// it is not set.
+func User(t *testing.T) *openai.OrganizationUser {
+	t.Helper()
+
+	return userFixture.get(t, "user", func(ctx context.Context) (*openai.OrganizationUser, error) {
+		if TestUserId == "" {
+			return nil, fmt.Errorf("OPENAI_TEST_USER_ID must be set")
+		}
+
+		return SharedClient.Admin.Organization.Users.Get(ctx, TestUserId)
+	})
+}
+
+// Project creates a project for the duration of the test, archiving it once
+// the test completes. Projects cannot be deleted, so unlike Group and User it
+// is not shared between tests.
+func Project(t *testing.T) *openai.Project {
+	t.Helper()
+	preCheckFixture(t)
+
+	project, err := SharedClient.Admin.Organization.Projects.New(t.Context(), openai.AdminOrganizationProjectNewParams{
+		Name: sdkacctest.RandomWithPrefix("tf-project"),
+	})
+	if err != nil {
+		t.Skipf("Unable to provision the project fixture: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if _, err := SharedClient.Admin.Organization.Projects.Archive(context.Background(), project.ID); err != nil {
+			t.Errorf("Unable to archive project %q: %s", project.ID, err)
+		}
+	})
+
+	return project
+}
@@ -0,0 +1,234 @@
+package acctest
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// MockEnabled reports whether acceptance tests run against a mock server
+// started by TestMain instead of the OpenAI API. Set OPENAI_ACC_MOCK=1 to
+// enable it.
+var MockEnabled = os.Getenv("OPENAI_ACC_MOCK") == "1"
+
+const (
+	mockServerUserId       = "user_test"
+	mockServerReadyTimeout = 60 * time.Second
+	mockServerStopTimeout  = 10 * time.Second
+)
+
+// TestMain runs the tests and sweepers of a test binary. When MockEnabled is
+// set, it starts the mock server on a free port before running the tests and
+// stops it afterwards. Fixtures shared by the run are torn down once all
+// tests have completed.
+func TestMain(m *testing.M) {
+	resource.TestMain(&runner{m: m})
+}
+
+type runner struct {
+	m *testing.M
+}
+
+func (r *runner) Run() int {
+	if !MockEnabled {
+		defer runCleanups(context.Background())
+		return r.m.Run()
+	}
+
+	srv, err := startMockServer(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to start the mock server: %s\n", err)
+		return 1
+	}
+	defer srv.stop()
+
+	for key, value := range map[string]string{
+		"OPENAI_BASE_URL":     srv.baseUrl,
+		"OPENAI_ADMIN_KEY":    srv.adminKey,
+		"OPENAI_TEST_USER_ID": mockServerUserId,
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			fmt.Fprintf(os.Stderr, "Unable to set %s: %s\n", key, err)
+			return 1
+		}
+	}
+
+	TestBaseUrl = srv.baseUrl
+	TestAdminKey = srv.adminKey
+	TestUserId = mockServerUserId
+	configure()
+
+	defer runCleanups(context.Background())
+	return r.m.Run()
+}
+
+type mockServer struct {
+	baseUrl  string
+	adminKey string
+
+	cmd    *exec.Cmd
+	output *lockedBuffer
+	exited chan struct{}
+}
+
+// startMockServer starts internal/mockserver with bun, seeded with a random
+// admin key, and waits until it accepts requests.
+func startMockServer(ctx context.Context) (*mockServer, error) {
+	dir, err := mockServerDir()
+	if err != nil {
+		return nil, err
+	}
+
+	bun, err := exec.LookPath("bun")
+	if err != nil {
+		return nil, fmt.Errorf("bun is required to run the mock server: %w", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "node_modules")); errors.Is(err, os.ErrNotExist) {
+		cmd := exec.CommandContext(ctx, bun, "install", "--frozen-lockfile")
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return nil, fmt.Errorf("unable to install the mock server dependencies: %w\n%s", err, out)
+		}
+	}
+
+	port, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+
+	srv := &mockServer{
+		baseUrl:  "http://127.0.0.1:" + strconv.Itoa(port),
+		adminKey: "sk-admin-" + rand.Text(),
+		output:   &lockedBuffer{},
+		exited:   make(chan struct{}),
+	}
+
+	srv.cmd = exec.Command(bun, "run", "index.ts")
+	srv.cmd.Dir = dir
+	srv.cmd.Env = append(os.Environ(),
+		"PORT="+strconv.Itoa(port),
+		"MOCKSERVER_ADMIN_KEY="+srv.adminKey,
+		"MOCKSERVER_USER_ID="+mockServerUserId,
+	)
+	srv.cmd.Stdout = srv.output
+	srv.cmd.Stderr = srv.output
+
+	if err := srv.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("unable to start bun: %w", err)
+	}
+	go func() {
+		_ = srv.cmd.Wait()
+		close(srv.exited)
+	}()
+
+	if err := srv.waitReady(ctx); err != nil {
+		srv.stop()
+		return nil, fmt.Errorf("%w\n%s", err, srv.output.String())
+	}
+	// The output is only needed to explain startup failures.
+	srv.output.discard()
+
+	return srv, nil
+}
+
+func (s *mockServer) waitReady(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, mockServerReadyTimeout)
+	defer cancel()
+
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseUrl+"/__control/faults", nil)
+		if err != nil {
+			return err
+		}
+		if resp, err := http.DefaultClient.Do(req); err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		select {
+		case <-s.exited:
+			return fmt.Errorf("mock server exited: %s", s.cmd.ProcessState)
+		case <-ctx.Done():
+			return fmt.Errorf("mock server did not become ready: %w", ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func (s *mockServer) stop() {
+	if runtime.GOOS == "windows" {
+		_ = s.cmd.Process.Kill()
+	} else {
+		_ = s.cmd.Process.Signal(os.Interrupt)
+	}
+
+	select {
+	case <-s.exited:
+	case <-time.After(mockServerStopTimeout):
+		_ = s.cmd.Process.Kill()
+		<-s.exited
+	}
+}
+
+func mockServerDir() (string, error) {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", errors.New("unable to locate the mock server sources")
+	}
+	return filepath.Join(filepath.Dir(file), "..", "mockserver"), nil
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, fmt.Errorf("unable to find a free port: %w", err)
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+type lockedBuffer struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	discarded bool
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.discarded {
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) discard() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.discarded = true
+	b.buf.Reset()
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,46 @@
+package acctest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+)
+
+// RegisterSweepers registers the sweepers to be run by `go test -sweep`, which
+// sweeps the objects named with sweep.DefaultPrefixes. The options of Sweep are
+// read from the environment by sweep.OptionsFromEnv.
+func RegisterSweepers(sweepers []sweep.Sweeper) {
+	for _, sweeper := range sweepers {
+		resource.AddTestSweepers(sweeper.Name, &resource.Sweeper{
+			Name: sweeper.Name,
+			F: func(region string) error {
+				ctx := context.Background()
+
+				opts, err := sweep.OptionsFromEnv()
+				if err != nil {
+					return err
+				}
+
+				match, err := sweep.PrefixMatcher(sweep.DefaultPrefixes)
+				if err != nil {
+					return err
+				}
+
+				sweepables, err := sweeper.F(ctx, SharedClient, match)
+				if err != nil {
+					return fmt.Errorf("failed to collect %q: %w", sweeper.Name, err)
+				}
+
+				_, err = sweep.Sweep(ctx, sweepables, opts)
+				if err != nil {
+					return fmt.Errorf("failed to sweep %q: %w", sweeper.Name, err)
+				}
+
+				return nil
+			},
+			Dependencies: sweeper.Dependencies,
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package adminkey
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openai/openai-go/v3/option"
+)
+
+const Prefix = "sk-admin-"
+
+// expiryWindow is how long before the reported expiry a command source is
+// re-invoked, so that in-flight requests never carry an expired key.
+const expiryWindow = time.Minute
+
+var ErrInvalidPrefix = fmt.Errorf("admin key must start with '%s'", Prefix)
+
+// Validate reports whether key looks like an OpenAI admin key.
+func Validate(key string) error {
+	if !strings.HasPrefix(key, Prefix) {
+		return ErrInvalidPrefix
+	}
+	return nil
+}
+
+// FromFile reads an admin key from the file at path, ignoring surrounding
+// whitespace.
+func FromFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read admin key file: %w", err)
+	}
+
+	key := strings.TrimSpace(string(b))
+	if key == "" {
+		return "", fmt.Errorf("admin key file %q is empty", path)
+	}
+	if err := Validate(key); err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+// CommandOutput is the JSON document a credential command must print to
+// stdout.
+type CommandOutput struct {
+	AdminKey  string     `json:"admin_key"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CommandSource obtains an admin key by running an external command, in the
+// style of AWS' credential_process. The command is run through the system
+// shell and is re-invoked once the key it returned is about to expire.
+type CommandSource struct {
+	command string
+
+	mu        sync.Mutex
+	key       string
+	expiresAt time.Time
+}
+
+func NewCommandSource(command string) *CommandSource {
+	return &CommandSource{
+		command: command,
+	}
+}
+
+// AdminKey returns the cached admin key, running the command if there is no
+// key yet or the previous one has expired.
+func (s *CommandSource) AdminKey(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.key != "" && (s.expiresAt.IsZero() || time.Now().Add(expiryWindow).Before(s.expiresAt)) {
+		return s.key, nil
+	}
+
+	out, err := s.run(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.key = out.AdminKey
+	s.expiresAt = time.Time{}
+	if out.ExpiresAt != nil {
+		s.expiresAt = *out.ExpiresAt
+	}
+
+	return s.key, nil
+}
+
+func (s *CommandSource) run(ctx context.Context) (*CommandOutput, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", s.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", s.command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("admin key command failed: %w: %s", err, msg)
+		}
+		return nil, fmt.Errorf("admin key command failed: %w", err)
+	}
+
+	var out CommandOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("admin key command returned invalid JSON: %w", err)
+	}
+	if out.AdminKey == "" {
+		return nil, errors.New("admin key command returned an empty admin_key")
+	}
+	if err := Validate(out.AdminKey); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// Middleware implements option.Middleware, setting the Authorization header
+// of every request to the current admin key.
+func (s *CommandSource) Middleware(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+	key, err := s.AdminKey(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+key)
+	return next(req)
+}
@@ -0,0 +1,114 @@
+package adminkey
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFromFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid")
+	if err := os.WriteFile(valid, []byte("sk-admin-test\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := filepath.Join(dir, "invalid")
+	if err := os.WriteFile(invalid, []byte("sk-proj-test"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := FromFile(valid)
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if key != "sk-admin-test" {
+		t.Errorf("Expected sk-admin-test, got %s", key)
+	}
+
+	if _, err := FromFile(invalid); err != ErrInvalidPrefix {
+		t.Errorf("Expected %s, got %v", ErrInvalidPrefix, err)
+	}
+
+	if _, err := FromFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestCommandSource(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a POSIX shell")
+	}
+
+	counter := filepath.Join(t.TempDir(), "counter")
+
+	tests := []struct {
+		name      string
+		output    string
+		wantErr   string
+		wantCalls int
+	}{
+		{
+			name:      "no expiry",
+			output:    `{"admin_key": "sk-admin-test"}`,
+			wantCalls: 1,
+		},
+		{
+			name:      "far expiry",
+			output:    `{"admin_key": "sk-admin-test", "expires_at": "` + time.Now().Add(time.Hour).Format(time.RFC3339) + `"}`,
+			wantCalls: 1,
+		},
+		{
+			name:      "near expiry",
+			output:    `{"admin_key": "sk-admin-test", "expires_at": "` + time.Now().Add(time.Second).Format(time.RFC3339) + `"}`,
+			wantCalls: 2,
+		},
+		{
+			name:    "invalid prefix",
+			output:  `{"admin_key": "sk-proj-test"}`,
+			wantErr: ErrInvalidPrefix.Error(),
+		},
+		{
+			name:    "invalid json",
+			output:  `sk-admin-test`,
+			wantErr: "invalid JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = os.Remove(counter)
+
+			s := NewCommandSource("echo x >> '" + counter + "'; echo '" + tt.output + "'")
+
+			for range 2 {
+				key, err := s.AdminKey(context.Background())
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("Expected no error, got %s", err)
+				}
+				if key != "sk-admin-test" {
+					t.Errorf("Expected sk-admin-test, got %s", key)
+				}
+			}
+
+			b, err := os.ReadFile(counter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Count(string(b), "x"); got != tt.wantCalls {
+				t.Errorf("Expected %d calls, got %d", tt.wantCalls, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package apicache
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openai/openai-go/v3/option"
+)
+
+// Cache is a request-coalescing cache for successful GET responses. A single
+// Cache is shared by every resource and data source configured by the same
+// provider instance, so that e.g. many `openai_project_rate_limit` resources
+// belonging to one project only list the project's rate limits once per run.
+//
+// Entries are keyed by the full request URL (endpoint and query parameters)
+// and are dropped whenever a non-GET request is sent to the same collection.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	path string
+	done chan struct{}
+	resp *cachedResponse
+}
+
+type cachedResponse struct {
+	status     string
+	statusCode int
+	proto      string
+	protoMajor int
+	protoMinor int
+	header     http.Header
+	body       []byte
+}
+
+func New() *Cache {
+	return &Cache{
+		entries: make(map[string]*entry),
+	}
+}
+
+// Middleware implements option.Middleware.
+func (c *Cache) Middleware(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		c.invalidate(req.URL.Path)
+		resp, err := next(req)
+		// Invalidate again in case a GET to the same collection was started
+		// while this request was in flight.
+		c.invalidate(req.URL.Path)
+		return resp, err
+	}
+
+	key := req.URL.String()
+
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-e.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		if e.resp != nil {
+			tflog.Debug(req.Context(), "Serving cached API response", map[string]any{
+				"url": key,
+			})
+			return e.resp.toResponse(req), nil
+		}
+
+		// The leading request failed, so send our own.
+		return next(req)
+	}
+
+	e := &entry{
+		path: req.URL.Path,
+		done: make(chan struct{}),
+	}
+	c.entries[key] = e
+	c.mu.Unlock()
+
+	resp, err := next(req)
+	if err == nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		var cached *cachedResponse
+		cached, err = newCachedResponse(resp)
+		if err == nil {
+			e.resp = cached
+			resp = cached.toResponse(req)
+		} else {
+			resp = nil
+		}
+	}
+
+	c.mu.Lock()
+	if e.resp == nil && c.entries[key] == e {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	close(e.done)
+
+	return resp, err
+}
+
+// invalidate drops every entry whose path is within the same collection as p,
+// i.e. either path is a prefix of the other.
+func (c *Cache) invalidate(p string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, e := range c.entries {
+		if isSubpath(e.path, p) || isSubpath(p, e.path) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func isSubpath(parent, child string) bool {
+	parent = strings.TrimSuffix(parent, "/")
+	child = strings.TrimSuffix(child, "/")
+	return child == parent || strings.HasPrefix(child, parent+"/")
+}
+
+func newCachedResponse(resp *http.Response) (*cachedResponse, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachedResponse{
+		status:     resp.Status,
+		statusCode: resp.StatusCode,
+		proto:      resp.Proto,
+		protoMajor: resp.ProtoMajor,
+		protoMinor: resp.ProtoMinor,
+		header:     resp.Header.Clone(),
+		body:       body,
+	}, nil
+}
+
+func (r *cachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        r.status,
+		StatusCode:    r.statusCode,
+		Proto:         r.proto,
+		ProtoMajor:    r.protoMajor,
+		ProtoMinor:    r.protoMinor,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,134 @@
+package apicache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *atomic.Int64) {
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, r.Method+" "+r.URL.String())
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func doRequest(t *testing.T, c *Cache, method, url string) string {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.Middleware(req, http.DefaultClient.Do)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestCache(t *testing.T) {
+	srv, calls := newTestServer(t)
+	c := New()
+
+	list := srv.URL + "/projects/p1/rate_limits?limit=100"
+
+	for range 3 {
+		if got, want := doRequest(t, c, http.MethodGet, list), "GET /projects/p1/rate_limits?limit=100"; got != want {
+			t.Errorf("Expected %q, got %q", want, got)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Expected 1 call, got %d", got)
+	}
+
+	doRequest(t, c, http.MethodGet, srv.URL+"/projects/p1/rate_limits?limit=100&after=rl-a")
+	doRequest(t, c, http.MethodGet, srv.URL+"/projects/p2/rate_limits?limit=100")
+	if got := calls.Load(); got != 3 {
+		t.Errorf("Expected 3 calls, got %d", got)
+	}
+
+	doRequest(t, c, http.MethodPost, srv.URL+"/projects/p1/rate_limits/rl-a")
+	doRequest(t, c, http.MethodGet, list)
+	doRequest(t, c, http.MethodGet, srv.URL+"/projects/p2/rate_limits?limit=100")
+	if got := calls.Load(); got != 5 {
+		t.Errorf("Expected 5 calls, got %d", got)
+	}
+}
+
+func TestCache_Errors(t *testing.T) {
+	srv, calls := newTestServer(t)
+	c := New()
+
+	doRequest(t, c, http.MethodGet, srv.URL+"/missing")
+	doRequest(t, c, http.MethodGet, srv.URL+"/missing")
+	if got := calls.Load(); got != 2 {
+		t.Errorf("Expected 2 calls, got %d", got)
+	}
+}
+
+func TestCache_Coalescing(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		<-release
+		_, _ = io.WriteString(w, "ok")
+	}))
+	t.Cleanup(srv.Close)
+
+	c := New()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			if got := doRequest(t, c, http.MethodGet, srv.URL+"/projects"); got != "ok" {
+				t.Errorf("Expected %q, got %q", "ok", got)
+			}
+		})
+	}
+
+	<-started
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Expected 1 call, got %d", got)
+	}
+}
+
+func TestIsSubpath(t *testing.T) {
+	tests := []struct {
+		parent string
+		child  string
+		want   bool
+	}{
+		{"/organization/projects", "/organization/projects", true},
+		{"/organization/projects", "/organization/projects/proj_1", true},
+		{"/organization/projects/", "/organization/projects/proj_1", true},
+		{"/organization/projects", "/organization/projects_archive", false},
+		{"/organization/projects/proj_1", "/organization/projects", false},
+	}
+
+	for _, tt := range tests {
+		if got := isSubpath(tt.parent, tt.child); got != tt.want {
+			t.Errorf("isSubpath(%q, %q) = %v, want %v", tt.parent, tt.child, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Package contract checks that API responses match the SDK types they are
+// decoded into, so that drift between the mock server and the API, as
+// described by the SDK, is caught.
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/openai/openai-go/v3/packages/respjson"
+)
+
+// Check reports the fields of v, an API response decoded into an SDK type,
+// that do not match the type: unknown fields, required fields that are
+// missing, fields holding a value of the wrong type and constants holding
+// another value. Nested objects and arrays are checked recursively.
+func Check(v any) error {
+	var errs []error
+	check(reflect.ValueOf(v), "", &errs)
+	return errors.Join(errs...)
+}
+
+func check(v reflect.Value, path string, errs *[]error) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			check(v.Elem(), path, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			check(v.Index(i), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(a.String(), b.String())
+		})
+		for _, key := range keys {
+			check(v.MapIndex(key), join(path, key.String()), errs)
+		}
+	case reflect.Struct:
+		if _, ok := v.Type().FieldByName("JSON"); ok {
+			checkObject(v, path, errs)
+		}
+	}
+}
+
+// checkObject checks a struct generated from an object schema. An object may
+// embed other objects, e.g. when its schema extends another one, in which
+// case the fields of each are reported as extra fields of the others.
+func checkObject(v reflect.Value, path string, errs *[]error) {
+	objects := embeddedObjects(v)
+
+	var unknown []string
+	for _, key := range extraFields(v).MapKeys() {
+		name := key.String()
+		if !slices.ContainsFunc(objects[1:], func(object reflect.Value) bool {
+			return !extraFields(object).MapIndex(key).IsValid()
+		}) {
+			unknown = append(unknown, name)
+		}
+	}
+	slices.Sort(unknown)
+	for _, name := range unknown {
+		*errs = append(*errs, fmt.Errorf("%s: unknown field", join(path, name)))
+	}
+
+	for _, object := range objects {
+		meta := object.FieldByName("JSON")
+		for i := range object.NumField() {
+			f := object.Type().Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if f.Anonymous || name == "" || name == "-" {
+				continue
+			}
+			m, ok := meta.FieldByName(f.Name).Interface().(respjson.Field)
+			if !ok {
+				continue
+			}
+			checkField(object.Field(i), f, m, join(path, name), errs)
+		}
+	}
+}
+
+func checkField(v reflect.Value, f reflect.StructField, m respjson.Field, path string, errs *[]error) {
+	raw := m.Raw()
+	switch {
+	case m.Valid():
+		if want, ok := constant(v); ok && v.String() != want {
+			*errs = append(*errs, fmt.Errorf("%s: got %s, want %q", path, raw, want))
+			return
+		}
+		check(v, path, errs)
+	case raw == respjson.Omitted:
+		if strings.Contains(f.Tag.Get("api"), "required") {
+			*errs = append(*errs, fmt.Errorf("%s: missing required field", path))
+		}
+	case raw != respjson.Null:
+		*errs = append(*errs, fmt.Errorf("%s: invalid value %s for %s", path, raw, f.Type))
+	}
+}
+
+// embeddedObjects returns v followed by the objects it embeds.
+func embeddedObjects(v reflect.Value) []reflect.Value {
+	objects := []reflect.Value{v}
+	for i := range v.NumField() {
+		f := v.Type().Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if _, ok := f.Type.FieldByName("JSON"); ok {
+				objects = append(objects, embeddedObjects(v.Field(i))...)
+			}
+		}
+	}
+	return objects
+}
+
+func extraFields(object reflect.Value) reflect.Value {
+	return object.FieldByName("JSON").FieldByName("ExtraFields")
+}
+
+// constant returns the value of v if its type is a constant of the SDK, i.e.
+// a string type with a Default method returning the only valid value.
+func constant(v reflect.Value) (string, bool) {
+	if v.Kind() != reflect.String {
+		return "", false
+	}
+	m := v.MethodByName("Default")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 || m.Type().Out(0) != v.Type() {
+		return "", false
+	}
+	return m.Call(nil)[0].String(), true
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,100 @@
+package contract
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/openai/openai-go/v3"
+)
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+		v    any
+		want []string
+	}{
+		{
+			name: "valid",
+			data: `{"id":"group_1","name":"Group","group_type":"group","is_scim_managed":false,"created_at":1}`,
+			v:    &openai.Group{},
+		},
+		{
+			name: "unknown field",
+			data: `{"id":"group_1","name":"Group","group_type":"group","is_scim_managed":false,"created_at":1,"object":"group"}`,
+			v:    &openai.Group{},
+			want: []string{"object: unknown field"},
+		},
+		{
+			name: "missing required field",
+			data: `{"id":"group_1","name":"Group","is_scim_managed":false,"created_at":1}`,
+			v:    &openai.Group{},
+			want: []string{"group_type: missing required field"},
+		},
+		{
+			name: "null required field",
+			data: `{"id":"group_1","name":"Group","group_type":"group","is_scim_managed":false,"created_at":null}`,
+			v:    &openai.Group{},
+		},
+		{
+			name: "invalid value",
+			data: `{"id":"group_1","name":"Group","group_type":"group","is_scim_managed":"false","created_at":1}`,
+			v:    &openai.Group{},
+			want: []string{`is_scim_managed: invalid value "false" for bool`},
+		},
+		{
+			name: "invalid constant",
+			data: `{"object":"project","id":"proj_1","created_at":1}`,
+			v:    &openai.Project{},
+			want: []string{`object: got "project", want "organization.project"`},
+		},
+		{
+			name: "nested",
+			data: `{"id":"alert_1","currency":"USD","interval":"month","threshold_amount":1,"notification_channel":{"type":"email","recipient":"a@example.com"}}`,
+			v:    &openai.OrganizationSpendAlert{},
+			want: []string{
+				"notification_channel.recipient: unknown field",
+				"notification_channel.recipients: missing required field",
+			},
+		},
+		{
+			name: "array",
+			data: `{"id":"invite_1","email":"a@example.com","role":"reader","status":"pending","created_at":1,"projects":[{"id":"proj_1"}]}`,
+			v:    &openai.Invite{},
+			want: []string{"projects[0].role: missing required field"},
+		},
+		{
+			name: "embedded",
+			data: `{"value":"sk-admin-1","id":"key_1","created_at":1,"expires_at":null,"owner":{"id":"user_1"},"redacted_value":"sk-admin-***1"}`,
+			v:    &openai.AdminOrganizationAdminAPIKeyNewResponse{},
+		},
+		{
+			name: "embedded unknown field",
+			data: `{"value":"sk-admin-1","id":"key_1","created_at":1,"expires_at":null,"owner":{"id":"user_1"},"redacted_value":"sk-admin-***1","key":"sk-admin-1"}`,
+			v:    &openai.AdminOrganizationAdminAPIKeyNewResponse{},
+			want: []string{"key: unknown field"},
+		},
+		{
+			name: "embedded missing required field",
+			data: `{"value":"sk-admin-1","id":"key_1","created_at":1,"expires_at":null,"owner":{"id":"user_1"}}`,
+			v:    &openai.AdminOrganizationAdminAPIKeyNewResponse{},
+			want: []string{"redacted_value: missing required field"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tc.data), tc.v); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			if err := Check(tc.v); err != nil {
+				got = strings.Split(err.Error(), "\n")
+			}
+			if strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+				t.Errorf("got errors:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tc.want, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,605 @@
+package contract_test
+
+import (
+	"reflect"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/jianyuan/terraform-provider-openai/internal/acctest"
+	"github.com/jianyuan/terraform-provider-openai/internal/contract"
+	"github.com/openai/openai-go/v3"
+)
+
+// The tests in this file call every route of the mock server and check that
+// the responses match the SDK types. Run them against the mock server with:
+//
+//	OPENAI_ACC_MOCK=1 go test ./internal/contract/
+
+func TestMain(m *testing.M) {
+	acctest.TestMain(m)
+}
+
+// preCheck skips the test unless it runs against the mock server. The check
+// comes before any request, as the tests create objects they never delete and
+// must not reach a real organization.
+func preCheck(t *testing.T) {
+	t.Helper()
+
+	if !acctest.MockEnabled {
+		t.Skip("Contract tests are skipped unless OPENAI_ACC_MOCK=1")
+	}
+	acctest.PreCheckMockServer(t)
+}
+
+// checkResponse fails the test if the request failed or if its response does
+// not match the SDK type.
+func checkResponse(t *testing.T, name string, v any, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+	if err := contract.Check(v); err != nil {
+		t.Errorf("%s: response does not match the SDK type:\n%s", name, err)
+	}
+}
+
+// checkList is like checkResponse for a page of a list. Only the items are
+// checked, as the SDK page types only declare the fields used for paginating.
+func checkList(t *testing.T, name string, page any, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+	checkResponse(t, name, reflect.ValueOf(page).Elem().FieldByName("Data").Interface(), nil)
+}
+
+type autoPager[T any] interface {
+	Next() bool
+	Current() T
+	Err() error
+}
+
+// checkAutoPager checks every item of a list, requesting each page in turn.
+func checkAutoPager[T any](t *testing.T, name string, iter autoPager[T]) {
+	t.Helper()
+
+	for iter.Next() {
+		item := iter.Current()
+		checkResponse(t, name, &item, nil)
+	}
+	if err := iter.Err(); err != nil {
+		t.Fatalf("%s: %s", name, err)
+	}
+}
+
+func newProject(t *testing.T) *openai.Project {
+	t.Helper()
+
+	project, err := acctest.SharedClient.Admin.Organization.Projects.New(t.Context(), openai.AdminOrganizationProjectNewParams{
+		Name: sdkacctest.RandomWithPrefix("tf-contract"),
+	})
+	checkResponse(t, "Projects.New", project, err)
+
+	return project
+}
+
+func newGroup(t *testing.T) *openai.Group {
+	t.Helper()
+
+	group, err := acctest.SharedClient.Admin.Organization.Groups.New(t.Context(), openai.AdminOrganizationGroupNewParams{
+		Name: sdkacctest.RandomWithPrefix("tf-contract"),
+	})
+	checkResponse(t, "Groups.New", group, err)
+
+	return group
+}
+
+func newRole(t *testing.T) *openai.Role {
+	t.Helper()
+
+	role, err := acctest.SharedClient.Admin.Organization.Roles.New(t.Context(), openai.AdminOrganizationRoleNewParams{
+		RoleName:    sdkacctest.RandomWithPrefix("tf-contract"),
+		Permissions: []string{"api.organization.read"},
+	})
+	checkResponse(t, "Roles.New", role, err)
+
+	return role
+}
+
+func newProjectRole(t *testing.T, projectId string) *openai.Role {
+	t.Helper()
+
+	role, err := acctest.SharedClient.Admin.Organization.Projects.Roles.New(t.Context(), projectId, openai.AdminOrganizationProjectRoleNewParams{
+		RoleName:    sdkacctest.RandomWithPrefix("tf-contract"),
+		Permissions: []string{"api.model.read"},
+	})
+	checkResponse(t, "Projects.Roles.New", role, err)
+
+	return role
+}
+
+func TestAdminAPIKeys(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.AdminAPIKeys
+
+	key, err := client.New(ctx, openai.AdminOrganizationAdminAPIKeyNewParams{
+		Name:             sdkacctest.RandomWithPrefix("tf-contract"),
+		ExpiresInSeconds: openai.Int(3600),
+	})
+	checkResponse(t, "New", key, err)
+
+	got, err := client.Get(ctx, key.ID)
+	checkResponse(t, "Get", got, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, openai.AdminOrganizationAdminAPIKeyListParams{}))
+
+	deleted, err := client.Delete(ctx, key.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestDataRetention(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.DataRetention
+
+	got, err := client.Get(ctx)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, openai.AdminOrganizationDataRetentionUpdateParams{
+		RetentionType: openai.AdminOrganizationDataRetentionUpdateParamsRetentionType(got.Type),
+	})
+	checkResponse(t, "Update", updated, err)
+}
+
+func TestGroups(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Groups
+
+	group := newGroup(t)
+
+	got, err := client.Get(ctx, group.ID)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, group.ID, openai.AdminOrganizationGroupUpdateParams{
+		Name: group.Name + "-updated",
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, openai.AdminOrganizationGroupListParams{}))
+
+	deleted, err := client.Delete(ctx, group.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestGroupUsers(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Groups.Users
+
+	group := newGroup(t)
+
+	created, err := client.New(ctx, group.ID, openai.AdminOrganizationGroupUserNewParams{
+		UserID: acctest.TestUserId,
+	})
+	checkResponse(t, "New", created, err)
+
+	got, err := client.Get(ctx, group.ID, acctest.TestUserId)
+	checkResponse(t, "Get", got, err)
+
+	page, err := client.List(ctx, group.ID, openai.AdminOrganizationGroupUserListParams{})
+	checkList(t, "List", page, err)
+
+	deleted, err := client.Delete(ctx, group.ID, acctest.TestUserId)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestGroupRoles(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Groups.Roles
+
+	group := newGroup(t)
+	role := newRole(t)
+
+	created, err := client.New(ctx, group.ID, openai.AdminOrganizationGroupRoleNewParams{
+		RoleID: role.ID,
+	})
+	checkResponse(t, "New", created, err)
+
+	got, err := client.Get(ctx, group.ID, role.ID)
+	checkResponse(t, "Get", got, err)
+
+	page, err := client.List(ctx, group.ID, openai.AdminOrganizationGroupRoleListParams{})
+	checkList(t, "List", page, err)
+
+	deleted, err := client.Delete(ctx, group.ID, role.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestInvites(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Invites
+
+	project := newProject(t)
+
+	invite, err := client.New(ctx, openai.AdminOrganizationInviteNewParams{
+		Email: sdkacctest.RandomWithPrefix("tf-contract") + "@example.com",
+		Role:  openai.AdminOrganizationInviteNewParamsRoleReader,
+		Projects: []openai.AdminOrganizationInviteNewParamsProject{
+			{ID: project.ID, Role: "member"},
+		},
+	})
+	checkResponse(t, "New", invite, err)
+
+	got, err := client.Get(ctx, invite.ID)
+	checkResponse(t, "Get", got, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, openai.AdminOrganizationInviteListParams{}))
+
+	deleted, err := client.Delete(ctx, invite.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestRoles(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Roles
+
+	role := newRole(t)
+
+	got, err := client.Get(ctx, role.ID)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, role.ID, openai.AdminOrganizationRoleUpdateParams{
+		RoleName:    openai.String(role.Name + "-updated"),
+		Permissions: role.Permissions,
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, openai.AdminOrganizationRoleListParams{}))
+
+	deleted, err := client.Delete(ctx, role.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestUsers(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Users
+
+	got, err := client.Get(ctx, acctest.TestUserId)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, acctest.TestUserId, openai.AdminOrganizationUserUpdateParams{
+		Role: openai.String(got.Role),
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, openai.AdminOrganizationUserListParams{}))
+}
+
+func TestUserRoles(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Users.Roles
+
+	role := newRole(t)
+
+	created, err := client.New(ctx, acctest.TestUserId, openai.AdminOrganizationUserRoleNewParams{
+		RoleID: role.ID,
+	})
+	checkResponse(t, "New", created, err)
+
+	got, err := client.Get(ctx, acctest.TestUserId, role.ID)
+	checkResponse(t, "Get", got, err)
+
+	page, err := client.List(ctx, acctest.TestUserId, openai.AdminOrganizationUserRoleListParams{})
+	checkList(t, "List", page, err)
+
+	deleted, err := client.Delete(ctx, acctest.TestUserId, role.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestSpendLimit(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.SpendLimit
+
+	updated, err := client.Update(ctx, openai.AdminOrganizationSpendLimitUpdateParams{
+		Currency:        openai.AdminOrganizationSpendLimitUpdateParamsCurrencyUsd,
+		Interval:        openai.AdminOrganizationSpendLimitUpdateParamsIntervalMonth,
+		ThresholdAmount: 100,
+	})
+	checkResponse(t, "Update", updated, err)
+
+	got, err := client.Get(ctx)
+	checkResponse(t, "Get", got, err)
+
+	deleted, err := client.Delete(ctx)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestSpendAlerts(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.SpendAlerts
+
+	alert, err := client.New(ctx, openai.AdminOrganizationSpendAlertNewParams{
+		Currency:        openai.AdminOrganizationSpendAlertNewParamsCurrencyUsd,
+		Interval:        openai.AdminOrganizationSpendAlertNewParamsIntervalMonth,
+		ThresholdAmount: 100,
+		NotificationChannel: openai.AdminOrganizationSpendAlertNewParamsNotificationChannel{
+			Recipients: []string{"alerts@example.com"},
+		},
+	})
+	checkResponse(t, "New", alert, err)
+
+	got, err := client.Get(ctx, alert.ID)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, alert.ID, openai.AdminOrganizationSpendAlertUpdateParams{
+		Currency:        openai.AdminOrganizationSpendAlertUpdateParamsCurrencyUsd,
+		Interval:        openai.AdminOrganizationSpendAlertUpdateParamsIntervalMonth,
+		ThresholdAmount: 200,
+		NotificationChannel: openai.AdminOrganizationSpendAlertUpdateParamsNotificationChannel{
+			Recipients:    []string{"alerts@example.com"},
+			SubjectPrefix: openai.String("[OpenAI]"),
+		},
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, openai.AdminOrganizationSpendAlertListParams{}))
+
+	deleted, err := client.Delete(ctx, alert.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjects(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects
+
+	project, err := client.New(ctx, openai.AdminOrganizationProjectNewParams{
+		Name:      sdkacctest.RandomWithPrefix("tf-contract"),
+		Geography: openai.String("EU"),
+	})
+	checkResponse(t, "New", project, err)
+
+	got, err := client.Get(ctx, project.ID)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, project.ID, openai.AdminOrganizationProjectUpdateParams{
+		Name: openai.String(project.Name + "-updated"),
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, openai.AdminOrganizationProjectListParams{
+		IncludeArchived: openai.Bool(true),
+	}))
+
+	archived, err := client.Archive(ctx, project.ID)
+	checkResponse(t, "Archive", archived, err)
+}
+
+func TestProjectUsers(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.Users
+
+	project := newProject(t)
+
+	user, err := client.New(ctx, project.ID, openai.AdminOrganizationProjectUserNewParams{
+		UserID: openai.String(acctest.TestUserId),
+		Role:   "member",
+	})
+	checkResponse(t, "New", user, err)
+
+	got, err := client.Get(ctx, project.ID, user.ID)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, project.ID, user.ID, openai.AdminOrganizationProjectUserUpdateParams{
+		Role: openai.String("owner"),
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, project.ID, openai.AdminOrganizationProjectUserListParams{}))
+
+	deleted, err := client.Delete(ctx, project.ID, user.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjectServiceAccounts(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.ServiceAccounts
+
+	project := newProject(t)
+
+	serviceAccount, err := client.New(ctx, project.ID, openai.AdminOrganizationProjectServiceAccountNewParams{
+		Name: sdkacctest.RandomWithPrefix("tf-contract"),
+	})
+	checkResponse(t, "New", serviceAccount, err)
+
+	got, err := client.Get(ctx, project.ID, serviceAccount.ID)
+	checkResponse(t, "Get", got, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, project.ID, openai.AdminOrganizationProjectServiceAccountListParams{}))
+
+	deleted, err := client.Delete(ctx, project.ID, serviceAccount.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjectRateLimits(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.RateLimits
+
+	project := newProject(t)
+
+	page, err := client.ListRateLimits(ctx, project.ID, openai.AdminOrganizationProjectRateLimitListRateLimitsParams{})
+	checkList(t, "ListRateLimits", page, err)
+	if len(page.Data) == 0 {
+		t.Fatal("ListRateLimits: no rate limits returned")
+	}
+
+	updated, err := client.UpdateRateLimit(ctx, project.ID, page.Data[0].ID, openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams{
+		MaxRequestsPer1Minute: openai.Int(10),
+	})
+	checkResponse(t, "UpdateRateLimit", updated, err)
+}
+
+func TestProjectModelPermissions(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.ModelPermissions
+
+	project := newProject(t)
+
+	updated, err := client.Update(ctx, project.ID, openai.AdminOrganizationProjectModelPermissionUpdateParams{
+		Mode:     openai.AdminOrganizationProjectModelPermissionUpdateParamsModeAllowList,
+		ModelIDs: []string{"gpt-4o"},
+	})
+	checkResponse(t, "Update", updated, err)
+
+	got, err := client.Get(ctx, project.ID)
+	checkResponse(t, "Get", got, err)
+
+	deleted, err := client.Delete(ctx, project.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjectRoles(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.Roles
+
+	project := newProject(t)
+	role := newProjectRole(t, project.ID)
+
+	got, err := client.Get(ctx, project.ID, role.ID)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, project.ID, role.ID, openai.AdminOrganizationProjectRoleUpdateParams{
+		RoleName:    openai.String(role.Name + "-updated"),
+		Permissions: role.Permissions,
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, project.ID, openai.AdminOrganizationProjectRoleListParams{}))
+
+	deleted, err := client.Delete(ctx, project.ID, role.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjectGroupRoles(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.Groups.Roles
+
+	project := newProject(t)
+	group := newGroup(t)
+	role := newProjectRole(t, project.ID)
+
+	created, err := client.New(ctx, project.ID, group.ID, openai.AdminOrganizationProjectGroupRoleNewParams{
+		RoleID: role.ID,
+	})
+	checkResponse(t, "New", created, err)
+
+	got, err := client.Get(ctx, project.ID, group.ID, role.ID)
+	checkResponse(t, "Get", got, err)
+
+	page, err := client.List(ctx, project.ID, group.ID, openai.AdminOrganizationProjectGroupRoleListParams{})
+	checkList(t, "List", page, err)
+
+	// The group has access to the project through its role assignment.
+	groups, err := acctest.SharedClient.Admin.Organization.Projects.Groups.List(ctx, project.ID, openai.AdminOrganizationProjectGroupListParams{})
+	checkList(t, "Projects.Groups.List", groups, err)
+
+	deleted, err := client.Delete(ctx, project.ID, group.ID, role.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjectUserRoles(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.Users.Roles
+
+	project := newProject(t)
+	role := newProjectRole(t, project.ID)
+
+	created, err := client.New(ctx, project.ID, acctest.TestUserId, openai.AdminOrganizationProjectUserRoleNewParams{
+		RoleID: role.ID,
+	})
+	checkResponse(t, "New", created, err)
+
+	got, err := client.Get(ctx, project.ID, acctest.TestUserId, role.ID)
+	checkResponse(t, "Get", got, err)
+
+	page, err := client.List(ctx, project.ID, acctest.TestUserId, openai.AdminOrganizationProjectUserRoleListParams{})
+	checkList(t, "List", page, err)
+
+	deleted, err := client.Delete(ctx, project.ID, acctest.TestUserId, role.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjectSpendLimit(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.SpendLimit
+
+	project := newProject(t)
+
+	updated, err := client.Update(ctx, project.ID, openai.AdminOrganizationProjectSpendLimitUpdateParams{
+		Currency:        openai.AdminOrganizationProjectSpendLimitUpdateParamsCurrencyUsd,
+		Interval:        openai.AdminOrganizationProjectSpendLimitUpdateParamsIntervalMonth,
+		ThresholdAmount: 100,
+	})
+	checkResponse(t, "Update", updated, err)
+
+	got, err := client.Get(ctx, project.ID)
+	checkResponse(t, "Get", got, err)
+
+	deleted, err := client.Delete(ctx, project.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
+
+func TestProjectSpendAlerts(t *testing.T) {
+	preCheck(t)
+	ctx := t.Context()
+	client := acctest.SharedClient.Admin.Organization.Projects.SpendAlerts
+
+	project := newProject(t)
+
+	alert, err := client.New(ctx, project.ID, openai.AdminOrganizationProjectSpendAlertNewParams{
+		Currency:        openai.AdminOrganizationProjectSpendAlertNewParamsCurrencyUsd,
+		Interval:        openai.AdminOrganizationProjectSpendAlertNewParamsIntervalMonth,
+		ThresholdAmount: 100,
+		NotificationChannel: openai.AdminOrganizationProjectSpendAlertNewParamsNotificationChannel{
+			Recipients: []string{"alerts@example.com"},
+		},
+	})
+	checkResponse(t, "New", alert, err)
+
+	got, err := client.Get(ctx, project.ID, alert.ID)
+	checkResponse(t, "Get", got, err)
+
+	updated, err := client.Update(ctx, project.ID, alert.ID, openai.AdminOrganizationProjectSpendAlertUpdateParams{
+		Currency:        openai.AdminOrganizationProjectSpendAlertUpdateParamsCurrencyUsd,
+		Interval:        openai.AdminOrganizationProjectSpendAlertUpdateParamsIntervalMonth,
+		ThresholdAmount: 200,
+		NotificationChannel: openai.AdminOrganizationProjectSpendAlertUpdateParamsNotificationChannel{
+			Recipients: []string{"alerts@example.com"},
+		},
+	})
+	checkResponse(t, "Update", updated, err)
+
+	checkAutoPager(t, "List", client.ListAutoPaging(ctx, project.ID, openai.AdminOrganizationProjectSpendAlertListParams{}))
+
+	deleted, err := client.Delete(ctx, project.ID, alert.ID)
+	checkResponse(t, "Delete", deleted, err)
+}
@@ -0,0 +1,111 @@
+package diag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jianyuan/terraform-provider-openai/internal/readonly"
+	"github.com/openai/openai-go/v3"
+)
+
+// Schema is implemented by the schemas of plans, states and configs, and is
+// used to resolve the API's `param` field to an attribute path.
+type Schema interface {
+	TypeAtPath(context.Context, path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// NewClientError returns a diagnostic describing err, as returned by the
+// OpenAI client while performing operation (e.g. "create").
+//
+// Errors returned by the API are reported with the HTTP status, error code,
+// error type and request ID, and are attached to the attribute named by the
+// error's `param` field when it exists in schema.
+func NewClientError(ctx context.Context, schema Schema, operation string, err error) diag.Diagnostic {
+	apiErr, ok := errors.AsType[*openai.Error](err)
+	if !ok {
+		return diag.NewErrorDiagnostic("Client Error", fmt.Sprintf("Unable to %s, got error: %s", operation, err))
+	}
+
+	if apiErr.Code == readonly.ErrorCode {
+		return diag.NewErrorDiagnostic("Read-Only Mode", apiErr.Message)
+	}
+
+	message := apiErr.Message
+	if message == "" {
+		message = apiErr.RawJSON()
+	}
+
+	var detail strings.Builder
+	fmt.Fprintf(&detail, "Unable to %s, the OpenAI API returned an error: %s\n", operation, message)
+	fmt.Fprintf(&detail, "\nHTTP status: %d %s", apiErr.StatusCode, http.StatusText(apiErr.StatusCode))
+	if apiErr.Code != "" {
+		fmt.Fprintf(&detail, "\nError code: %s", apiErr.Code)
+	}
+	if apiErr.Type != "" {
+		fmt.Fprintf(&detail, "\nError type: %s", apiErr.Type)
+	}
+	if apiErr.Param != "" {
+		fmt.Fprintf(&detail, "\nParameter: %s", apiErr.Param)
+	}
+	if apiErr.Response != nil {
+		if requestId := apiErr.Response.Header.Get("x-request-id"); requestId != "" {
+			fmt.Fprintf(&detail, "\nRequest ID: %s", requestId)
+		}
+	}
+	if apiErr.Request != nil {
+		fmt.Fprintf(&detail, "\nRequest: %s %s", apiErr.Request.Method, apiErr.Request.URL)
+	}
+
+	summary := "API Error"
+	if apiErr.StatusCode != 0 {
+		summary = fmt.Sprintf("API Error (%d)", apiErr.StatusCode)
+	}
+
+	if p, ok := paramPath(ctx, schema, apiErr.Param); ok {
+		return diag.NewAttributeErrorDiagnostic(p, summary, detail.String())
+	}
+
+	return diag.NewErrorDiagnostic(summary, detail.String())
+}
+
+var paramIndexRe = regexp.MustCompile(`\[(\d+)\]`)
+
+// paramPath resolves an API parameter such as `rate_limits[0].max_tokens` to
+// the deepest matching attribute path in schema.
+func paramPath(ctx context.Context, schema Schema, param string) (path.Path, bool) {
+	if schema == nil || param == "" {
+		return path.Empty(), false
+	}
+
+	segments := strings.Split(paramIndexRe.ReplaceAllString(param, ".$1"), ".")
+
+	var p path.Path
+	resolved := false
+	for i, segment := range segments {
+		var next path.Path
+		if i == 0 {
+			next = path.Root(segment)
+		} else if index, err := strconv.Atoi(segment); err == nil {
+			next = p.AtListIndex(index)
+		} else {
+			next = p.AtName(segment)
+		}
+
+		if _, diags := schema.TypeAtPath(ctx, next); diags.HasError() {
+			break
+		}
+
+		p = next
+		resolved = true
+	}
+
+	return p, resolved
+}
@@ -0,0 +1,138 @@
+package diag
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/option"
+)
+
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"rate_limits": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"max_tokens": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+		"recipients": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+	},
+}
+
+func newTestError(t *testing.T, param string) error {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req_123")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error": {"message": "Invalid value.", "type": "invalid_request_error", "code": "invalid_value", "param": "` + param + `"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := openai.NewClient(
+		option.WithBaseURL(srv.URL),
+		option.WithAdminAPIKey("sk-admin-test"),
+		option.WithMaxRetries(0),
+	)
+
+	_, err := client.Admin.Organization.Projects.New(context.Background(), openai.AdminOrganizationProjectNewParams{
+		Name: "Test",
+	})
+	if err == nil {
+		t.Fatal("Expected error, got none")
+	}
+	return err
+}
+
+func TestNewClientError(t *testing.T) {
+	ctx := context.Background()
+
+	d := NewClientError(ctx, testSchema, "create", newTestError(t, "name"))
+
+	if d.Severity() != diag.SeverityError {
+		t.Errorf("Expected error severity, got %s", d.Severity())
+	}
+	if d.Summary() != "API Error (400)" {
+		t.Errorf("Expected summary %q, got %q", "API Error (400)", d.Summary())
+	}
+	for _, want := range []string{
+		"Unable to create, the OpenAI API returned an error: Invalid value.",
+		"HTTP status: 400 Bad Request",
+		"Error code: invalid_value",
+		"Error type: invalid_request_error",
+		"Parameter: name",
+		"Request ID: req_123",
+		"Request: POST ",
+	} {
+		if !strings.Contains(d.Detail(), want) {
+			t.Errorf("Expected detail to contain %q, got %q", want, d.Detail())
+		}
+	}
+
+	withPath, ok := d.(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("Expected diagnostic with path, got %T", d)
+	}
+	if !withPath.Path().Equal(path.Root("name")) {
+		t.Errorf("Expected path name, got %s", withPath.Path())
+	}
+}
+
+func TestNewClientError_NonAPIError(t *testing.T) {
+	d := NewClientError(context.Background(), testSchema, "delete", errors.New("connection reset"))
+
+	if d.Summary() != "Client Error" {
+		t.Errorf("Expected summary %q, got %q", "Client Error", d.Summary())
+	}
+	if d.Detail() != "Unable to delete, got error: connection reset" {
+		t.Errorf("Unexpected detail %q", d.Detail())
+	}
+}
+
+func TestParamPath(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		param  string
+		want   path.Path
+		wantOk bool
+	}{
+		{"", path.Empty(), false},
+		{"unknown", path.Empty(), false},
+		{"name", path.Root("name"), true},
+		{"rate_limits[1].max_tokens", path.Root("rate_limits").AtListIndex(1).AtName("max_tokens"), true},
+		{"rate_limits.1.max_tokens", path.Root("rate_limits").AtListIndex(1).AtName("max_tokens"), true},
+		{"rate_limits[1].unknown", path.Root("rate_limits").AtListIndex(1), true},
+		{"recipients[0]", path.Root("recipients"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.param, func(t *testing.T) {
+			got, ok := paramPath(ctx, testSchema, tt.param)
+			if ok != tt.wantOk {
+				t.Fatalf("Expected ok %v, got %v", tt.wantOk, ok)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
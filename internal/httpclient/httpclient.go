@@ -0,0 +1,103 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// Config describes the transport settings of the HTTP client used to talk to
+// the OpenAI API. The zero value uses the system defaults.
+type Config struct {
+	// ProxyURL overrides the proxy taken from the HTTP_PROXY, HTTPS_PROXY and
+	// NO_PROXY environment variables.
+	ProxyURL string
+
+	// CABundleFile and CABundlePEM are PEM-encoded CA certificates that are
+	// trusted in addition to the system roots.
+	CABundleFile string
+	CABundlePEM  string
+
+	// ClientCertificate and ClientKey are a PEM-encoded certificate and private
+	// key presented for mutual TLS.
+	ClientCertificate string
+	ClientKey         string
+
+	InsecureSkipVerify bool
+}
+
+// IsZero reports whether c would produce the default client.
+func (c Config) IsZero() bool {
+	return c == Config{}
+}
+
+// New builds an *http.Client from c.
+func New(c Config) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if c.ProxyURL != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: scheme and host are required", c.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.CABundleFile != "" || c.CABundlePEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+
+		if c.CABundleFile != "" {
+			b, err := os.ReadFile(c.CABundleFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read CA bundle file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(b) {
+				return nil, fmt.Errorf("CA bundle file %q contains no PEM-encoded certificates", c.CABundleFile)
+			}
+		}
+
+		if c.CABundlePEM != "" {
+			if !pool.AppendCertsFromPEM([]byte(c.CABundlePEM)) {
+				return nil, errors.New("CA bundle contains no PEM-encoded certificates")
+			}
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.ClientCertificate != "" || c.ClientKey != "" {
+		if c.ClientCertificate == "" || c.ClientKey == "" {
+			return nil, errors.New("client certificate and client key must be set together")
+		}
+
+		cert, err := tls.X509KeyPair([]byte(c.ClientCertificate), []byte(c.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.InsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return &http.Client{
+		Transport: transport,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package httpclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestNew_TLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: srv.Certificate().Raw,
+	}))
+
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{
+			name:    "system roots",
+			config:  Config{},
+			wantErr: true,
+		},
+		{
+			name:   "ca bundle",
+			config: Config{CABundlePEM: caBundle},
+		},
+		{
+			name:   "insecure",
+			config: Config{InsecureSkipVerify: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := New(tt.config)
+			if err != nil {
+				t.Fatalf("Expected no error, got %s", err)
+			}
+
+			resp, err := client.Get(srv.URL)
+			if tt.wantErr {
+				if err == nil {
+					resp.Body.Close()
+					t.Fatal("Expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %s", err)
+			}
+			resp.Body.Close()
+		})
+	}
+}
+
+func TestNew_Proxy(t *testing.T) {
+	client, err := New(Config{ProxyURL: "http://proxy.example.com:3128"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, got %T", client.Transport)
+	}
+
+	proxyURL, err := transport.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "api.openai.com"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+	if proxyURL.String() != "http://proxy.example.com:3128" {
+		t.Errorf("Expected http://proxy.example.com:3128, got %s", proxyURL)
+	}
+}
+
+func TestNew_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{"invalid proxy", Config{ProxyURL: "proxy.example.com"}},
+		{"invalid ca bundle", Config{CABundlePEM: "not a certificate"}},
+		{"missing ca bundle file", Config{CABundleFile: "/nonexistent/ca.pem"}},
+		{"certificate without key", Config{ClientCertificate: "cert"}},
+		{"invalid client certificate", Config{ClientCertificate: "cert", ClientKey: "key"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.config); err == nil {
+				t.Error("Expected error, got none")
+			}
+		})
+	}
+}
@@ -7,6 +7,9 @@ import {
 } from "drizzle-orm/sqlite-core";
 import { idGenerator, now } from "./db-utils";
 
+// The tables of the resources whose routes are generated by providergen.
+export * from "./generated/db-schema";
+
 const objectColumn = (key: string) =>
   text({ enum: [key] })
     .notNull()
@@ -30,6 +33,7 @@ export const adminApiKeys = sqliteTable("admin_api_keys", {
   id: text().primaryKey().$defaultFn(idGenerator("admin_api_key_")),
   name: text().notNull(),
   value: text().notNull().$defaultFn(idGenerator("sk-admin-")),
+  expires_at: integer(),
   created_at: createdAtColumn(),
 });
 
@@ -88,6 +92,7 @@ export const roles = sqliteTable("roles", {
   resource_type: text({ enum: ["api.organization", "api.project"] }).notNull(),
   predefined_role: integer({ mode: "boolean" }).notNull().default(false),
   created_at: createdAtColumn(),
+  updated_at: integer().notNull().$defaultFn(now).$onUpdateFn(now),
 });
 
 export const rolesRelation = relations(roles, ({ many }) => ({
@@ -142,6 +147,10 @@ export const invites = sqliteTable("invites", {
   status: text({ enum: ["accepted", "expired", "pending"] })
     .notNull()
     .default("pending"),
+  projects: text({ mode: "json" })
+    .notNull()
+    .$type<{ id: string; role: "member" | "owner" }[]>()
+    .$defaultFn(() => []),
   accepted_at: integer(),
   expires_at: integer().$defaultFn(() => now() + 60 * 60 * 24),
   created_at: createdAtColumn(),
@@ -374,27 +383,6 @@ export const projectSpendLimits = sqliteTable("project_spend_limits", {
   }>(),
 });
 
-export const spendAlerts = sqliteTable("spend_alerts", {
-  object: objectColumn("organization.spend_alert"),
-  id: text().primaryKey().$defaultFn(idGenerator("alert_")),
-  currency: text({
-    enum: ["USD"],
-  })
-    .notNull()
-    .default("USD"),
-  interval: text({
-    enum: ["month"],
-  })
-    .notNull()
-    .default("month"),
-  threshold_amount: integer().notNull(),
-  notification_channel: text({ mode: "json" }).notNull().$type<{
-    type: "email";
-    recipients: string[];
-    subject_prefix?: string;
-  }>(),
-});
-
 export const projectSpendAlerts = sqliteTable("project_spend_alerts", {
   object: objectColumn("project.spend_alert"),
   id: text().primaryKey().$defaultFn(idGenerator("alert_")),
@@ -3,6 +3,7 @@ import { pushSQLiteSchema } from "drizzle-kit/api";
 import { drizzle } from "drizzle-orm/bun-sqlite";
 import * as schema from "./db-schema";
 import { now } from "./db-utils";
+import predefinedRoles from "./fixtures/predefined-roles.json";
 
 const sqlite = new Database(":memory:");
 export const db = drizzle({ client: sqlite, schema });
@@ -17,7 +18,7 @@ async function applyMigrations() {
 async function seedDatabase() {
   await db.insert(schema.adminApiKeys).values({
     name: "test",
-    value: "sk-admin-test",
+    value: Bun.env.MOCKSERVER_ADMIN_KEY ?? "sk-admin-test",
   });
 
   await db.insert(schema.dataRetentions).values({
@@ -25,49 +26,22 @@ async function seedDatabase() {
   });
 
   await db.insert(schema.users).values({
-    id: "user_test",
+    id: Bun.env.MOCKSERVER_USER_ID ?? "user_test",
     name: "John Doe",
     email: "john.doe@example.com",
     role: "owner",
   });
 
-  await db.insert(schema.roles).values({
-    id: "role_organization_owner",
-    name: "owner",
-    description:
-      "Can modify billing information and manage organization members",
-    permissions: ["*"],
-    predefined_role: true,
-    resource_type: "api.organization",
-  });
-
-  await db.insert(schema.roles).values({
-    id: "role_organization_reader",
-    name: "reader",
-    description:
-      "Can make standard API requests and read basic organizational data",
-    permissions: ["*"],
-    predefined_role: true,
-    resource_type: "api.organization",
-  });
-
-  await db.insert(schema.roles).values({
-    id: "role_project_owner",
-    name: "owner",
-    description: "",
-    permissions: ["*"],
-    predefined_role: true,
-    resource_type: "api.project",
-  });
-
-  await db.insert(schema.roles).values({
-    id: "role_project_member",
-    name: "member",
-    description: "",
-    permissions: ["*"],
-    predefined_role: true,
-    resource_type: "api.project",
-  });
+  // The predefined roles, whose permissions the built-in permissions of the
+  // provider are checked against.
+  await db.insert(schema.roles).values(
+    predefinedRoles.map((role) => ({
+      ...role,
+      resource_type:
+        role.resource_type as (typeof schema.roles.$inferInsert)["resource_type"],
+      predefined_role: true,
+    })),
+  );
 
   const [project] = await db
     .insert(schema.projects)
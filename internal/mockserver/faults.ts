@@ -0,0 +1,198 @@
+import { zValidator } from "@hono/zod-validator";
+import { Hono } from "hono";
+import { createMiddleware } from "hono/factory";
+import z from "zod";
+
+const faultSchema = z.discriminatedUnion("type", [
+  // Respond with an error status instead of handling the request.
+  z.object({
+    type: z.literal("status"),
+    status: z.number().int().min(400).max(599),
+    retry_after: z.number().int().nonnegative().optional(),
+  }),
+  // Wait, then handle the request, e.g. to trigger client timeouts. A client
+  // that timed out has gone by then, but the request is still handled, as by
+  // the API.
+  z.object({
+    type: z.literal("delay"),
+    delay_ms: z.number().int().positive(),
+  }),
+  // Close the connection without sending a response.
+  z.object({
+    type: z.literal("drop"),
+  }),
+  // Hide the object created by the matching request from the next
+  // `hidden_requests` GET requests that reference its ID.
+  z.object({
+    type: z.literal("not_visible"),
+    hidden_requests: z.number().int().positive(),
+  }),
+]);
+
+const faultRuleSchema = z.object({
+  method: z.string().toUpperCase().optional(),
+  path: z.string(),
+  // Number of matching requests the fault applies to. Omit to apply the fault
+  // until it is cleared.
+  count: z.number().int().positive().optional(),
+  fault: faultSchema,
+});
+
+type FaultRule = z.infer<typeof faultRuleSchema> & {
+  id: number;
+  pattern: RegExp;
+  remaining: number | undefined;
+  matched: number;
+};
+
+let nextRuleId = 1;
+let rules: Array<FaultRule> = [];
+const hiddenIds = new Map<string, number>();
+
+function findRule(method: string, path: string) {
+  const rule = rules.find(
+    (rule) =>
+      (rule.method === undefined || rule.method === method) &&
+      rule.pattern.test(path) &&
+      (rule.remaining === undefined || rule.remaining > 0),
+  );
+  if (rule) {
+    rule.matched++;
+    if (rule.remaining !== undefined) {
+      rule.remaining--;
+    }
+  }
+  return rule;
+}
+
+function serializeRule({ pattern, remaining, ...rule }: FaultRule) {
+  return { ...rule, remaining: remaining ?? null };
+}
+
+// Erroring the body stream before anything is written makes Bun close the
+// connection without a response.
+function droppedResponse() {
+  return new Response(
+    new ReadableStream({
+      start(controller) {
+        controller.error(new Error("Injected fault: connection dropped"));
+      },
+    }),
+  );
+}
+
+export const injectFaults = createMiddleware(async (c, next) => {
+  const method = c.req.method;
+  const path = c.req.path;
+
+  if (method === "GET") {
+    const segments = path.split("/");
+    for (const [id, remaining] of hiddenIds) {
+      if (segments.includes(id)) {
+        if (remaining <= 1) {
+          hiddenIds.delete(id);
+        } else {
+          hiddenIds.set(id, remaining - 1);
+        }
+        return c.json(
+          {
+            error: {
+              message: `No such object: ${id}`,
+              type: "invalid_request_error",
+              code: null,
+              param: null,
+            },
+          },
+          404,
+        );
+      }
+    }
+  }
+
+  const rule = findRule(method, path);
+  if (!rule) {
+    return await next();
+  }
+
+  const fault = rule.fault;
+  switch (fault.type) {
+    case "status": {
+      if (fault.retry_after !== undefined) {
+        c.header("Retry-After", String(fault.retry_after));
+      }
+      return c.json(
+        {
+          error: {
+            message: `Injected fault: ${fault.status}`,
+            type: "server_error",
+            code: null,
+            param: null,
+          },
+        },
+        fault.status as any,
+      );
+    }
+    case "delay": {
+      await Bun.sleep(fault.delay_ms);
+      return await next();
+    }
+    case "drop": {
+      return droppedResponse();
+    }
+    case "not_visible": {
+      await next();
+      if (c.res.ok) {
+        const body = await c.res.clone().json();
+        if (typeof body?.id === "string") {
+          hiddenIds.set(body.id, fault.hidden_requests);
+        }
+      }
+      return;
+    }
+  }
+});
+
+const control = new Hono();
+
+control.get("/faults", (c) => {
+  return c.json({
+    object: "list",
+    data: rules.map(serializeRule),
+  });
+});
+
+control.post("/faults", zValidator("json", faultRuleSchema), (c) => {
+  const body = c.req.valid("json");
+
+  const rule: FaultRule = {
+    ...body,
+    id: nextRuleId++,
+    pattern: new RegExp(body.path),
+    remaining: body.count,
+    matched: 0,
+  };
+  rules.push(rule);
+
+  return c.json(serializeRule(rule));
+});
+
+control.delete("/faults", (c) => {
+  rules = [];
+  hiddenIds.clear();
+
+  return c.json({ deleted: true });
+});
+
+control.delete("/faults/:fault_id", (c) => {
+  const fault_id = Number(c.req.param("fault_id"));
+
+  const rule = rules.find((rule) => rule.id === fault_id);
+  if (!rule) {
+    return c.json({ error: "Fault not found" }, 404);
+  }
+  rules = rules.filter((rule) => rule.id !== fault_id);
+
+  return c.json(serializeRule(rule));
+});
+
+export default control;
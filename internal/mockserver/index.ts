@@ -1,16 +1,18 @@
 import { eq } from "drizzle-orm";
 import { Hono } from "hono";
 import { bearerAuth } from "hono/bearer-auth";
+import { except } from "hono/combine";
 import { logger } from "hono/logger";
 import { prettyJSON } from "hono/pretty-json";
 import { db } from "./db";
 import * as schema from "./db-schema";
+import control, { injectFaults } from "./faults";
+import { routes as generatedRoutes } from "./generated/routes";
 import adminApiKeys from "./routes/admin-api-keys";
 import dataRetention from "./routes/data-retention";
 import spendLimit from "./routes/spend-limit";
 import projectSpendLimit from "./routes/project-spend-limit";
 import projectSpendAlerts from "./routes/project-spend-alerts";
-import spendAlerts from "./routes/spend-alerts";
 import roles from "./routes/roles";
 import groups from "./routes/groups";
 import groupRoles from "./routes/group-roles";
@@ -25,6 +27,7 @@ import projectServiceAccounts from "./routes/project-service-accounts";
 import projectRateLimits from "./routes/project-rate-limits";
 import projectModelPermissions from "./routes/project-model-permissions";
 import projectGroupRoles from "./routes/project-group-roles";
+import projectGroups from "./routes/project-groups";
 import projectUserRoles from "./routes/project-user-roles";
 
 const app = new Hono();
@@ -32,18 +35,24 @@ app.use(logger());
 app.use(prettyJSON());
 app.use(
   "/*",
-  bearerAuth({
-    verifyToken: async (token) => {
-      const apiKey = await db.query.adminApiKeys.findFirst({
-        where: eq(schema.adminApiKeys.value, token),
-      });
-      return !!apiKey;
-    },
-  }),
+  except(
+    "/__control/*",
+    bearerAuth({
+      verifyToken: async (token) => {
+        const apiKey = await db.query.adminApiKeys.findFirst({
+          where: eq(schema.adminApiKeys.value, token),
+        });
+        return !!apiKey;
+      },
+    }),
+    injectFaults,
+  ),
 );
 
 app.get("/", (c) => c.text("Hello World"));
 
+app.route("/__control", control);
+
 app.route("/organization/admin_api_keys", adminApiKeys);
 app.route("/organization/data_retention", dataRetention);
 app.route("/organization/spend_limit", spendLimit);
@@ -52,7 +61,6 @@ app.route(
   "/organization/projects/:project_id/spend_alerts",
   projectSpendAlerts,
 );
-app.route("/organization/spend_alerts", spendAlerts);
 app.route("/organization/roles", roles);
 app.route("/organization/groups", groups);
 app.route("/organization/groups/:group_id/roles", groupRoles);
@@ -72,7 +80,12 @@ app.route(
   "/organization/projects/:project_id/model_permissions",
   projectModelPermissions,
 );
+app.route("/organization/projects/:project_id/groups", projectGroups);
 app.route("/projects/:project_id/groups/:group_id/roles", projectGroupRoles);
 app.route("/projects/:project_id/users/:user_id/roles", projectUserRoles);
 
+for (const [path, route] of generatedRoutes) {
+  app.route(path, route);
+}
+
 export default app;
@@ -0,0 +1,81 @@
+import z from "zod";
+
+// MOCKSERVER_PAGE_SIZE caps every page to a small size, so that the
+// provider's pagination code paths are exercised even though it always asks
+// for 100 items per page.
+const pageSize = process.env.MOCKSERVER_PAGE_SIZE
+  ? z.coerce.number().int().positive().parse(process.env.MOCKSERVER_PAGE_SIZE)
+  : undefined;
+
+export const DEFAULT_LIMIT = pageSize ?? 20;
+export const MAX_LIMIT = pageSize ?? 100;
+
+export const paginationQuery = z.object({
+  limit: z.coerce.number().int().min(1).max(100).optional(),
+  after: z.string().optional(),
+  before: z.string().optional(),
+  order: z.enum(["asc", "desc"]).optional(),
+});
+
+export type PaginationQuery = z.infer<typeof paginationQuery>;
+
+// The Admin API uses two cursor styles: most lists return `first_id` and
+// `last_id`, while the groups and roles lists return a `next` cursor.
+export type PaginationStyle = "last_id" | "next";
+
+export function paginate<T>(
+  items: Array<T>,
+  query: PaginationQuery,
+  {
+    cursor,
+    style = "last_id",
+  }: {
+    cursor: (item: T) => string;
+    style?: PaginationStyle;
+  },
+) {
+  const ordered = query.order === "desc" ? [...items].reverse() : items;
+  const limit = Math.min(query.limit ?? DEFAULT_LIMIT, MAX_LIMIT);
+
+  let start = 0;
+  let end = ordered.length;
+  if (query.after !== undefined) {
+    const index = ordered.findIndex((item) => cursor(item) === query.after);
+    start = index === -1 ? ordered.length : index + 1;
+  }
+  if (query.before !== undefined) {
+    const index = ordered.findIndex((item) => cursor(item) === query.before);
+    end = index === -1 ? 0 : index;
+  }
+
+  let data: Array<T>;
+  let has_more: boolean;
+  if (query.before !== undefined && query.after === undefined) {
+    // Paging backwards returns the items immediately preceding the cursor.
+    data = ordered.slice(Math.max(start, end - limit), end);
+    has_more = end - limit > start;
+  } else {
+    data = ordered.slice(start, Math.min(start + limit, end));
+    has_more = start + limit < end;
+  }
+
+  const first_id = data.length > 0 ? cursor(data[0]!) : null;
+  const last_id = data.length > 0 ? cursor(data[data.length - 1]!) : null;
+
+  if (style === "next") {
+    return {
+      object: "list" as const,
+      data,
+      has_more,
+      next: has_more ? last_id : null,
+    };
+  }
+
+  return {
+    object: "list" as const,
+    data,
+    has_more,
+    first_id,
+    last_id,
+  };
+}
@@ -4,28 +4,70 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { now } from "../db-utils";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 
 const route = new Hono();
 
+// Admin API keys are owned by the organization owner seeded in db.ts.
+async function findOwner() {
+  return await db.query.users.findFirst({
+    where: eq(schema.users.role, "owner"),
+  });
+}
+
+route.get("/", zValidator("query", paginationQuery), async (c) => {
+  const apiKeys = await db.query.adminApiKeys.findMany();
+
+  const owner = await findOwner();
+  if (!owner) {
+    return c.json({ error: "Organization owner not found" }, 500);
+  }
+
+  return c.json(
+    paginate(
+      apiKeys.map((apiKey) => serializers.adminApiKey(apiKey, owner)),
+      c.req.valid("query"),
+      { cursor: (apiKey) => apiKey.id },
+    ),
+  );
+});
+
 route.post(
   "/",
-  zValidator("json", z.object({ name: z.string() })),
+  zValidator(
+    "json",
+    z.object({
+      name: z.string(),
+      expires_in_seconds: z.number().int().positive().optional(),
+    }),
+  ),
   async (c) => {
-    const { name } = c.req.valid("json");
+    const { name, expires_in_seconds } = c.req.valid("json");
 
     const [apiKey] = await db
       .insert(schema.adminApiKeys)
       .values({
         name,
+        expires_at:
+          expires_in_seconds !== undefined
+            ? now() + expires_in_seconds
+            : undefined,
       })
       .returning();
     if (!apiKey) {
       return c.json({ error: "Failed to create admin API key" }, 500);
     }
 
+    const owner = await findOwner();
+    if (!owner) {
+      return c.json({ error: "Organization owner not found" }, 500);
+    }
+
     return c.json({
-      ...apiKey,
-      redacted_value: `sk-admin-***${apiKey.value.slice(-3)}`,
+      ...serializers.adminApiKey(apiKey, owner),
+      value: apiKey.value,
     });
   },
 );
@@ -40,10 +82,12 @@ route.get("/:key_id", async (c) => {
     return c.json({ error: "Admin API key not found" }, 404);
   }
 
-  return c.json({
-    ...apiKey,
-    redacted_value: `sk-admin-***${apiKey.value.slice(-3)}`,
-  });
+  const owner = await findOwner();
+  if (!owner) {
+    return c.json({ error: "Organization owner not found" }, 500);
+  }
+
+  return c.json(serializers.adminApiKey(apiKey, owner));
 });
 
 route.delete("/:key_id", async (c) => {
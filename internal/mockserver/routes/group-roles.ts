@@ -4,6 +4,8 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 import { type GroupEnv, requireGroup } from "../middleware/group";
 
 const route = new Hono<GroupEnv>();
@@ -11,7 +13,7 @@ route.use(requireGroup);
 
 route.get(
   "/",
-  zValidator("query", z.object({ limit: z.coerce.number().optional() })),
+  zValidator("query", paginationQuery),
   async (c) => {
     const group = c.get("group");
 
@@ -22,14 +24,18 @@ route.get(
       },
     });
 
-    return c.json({
-      object: "list",
-      data: groupsToRoles.map((groupToRole) => ({
-        ...groupToRole.role,
-      })),
-      has_more: false,
-      next: null,
-    });
+    return c.json(
+      paginate(
+        groupsToRoles.map((groupToRole) =>
+          serializers.roleAssignment(groupToRole.role, {
+            type: "group",
+            id: group.id,
+          }),
+        ),
+        c.req.valid("query"),
+        { cursor: (role) => role.id, style: "next" },
+      ),
+    );
   },
 );
 
@@ -54,8 +60,8 @@ route.post(
 
     return c.json({
       object: "group.role",
-      group,
-      role,
+      group: serializers.groupSummary(group),
+      role: serializers.role(role),
     });
   },
 );
@@ -77,7 +83,12 @@ route.get("/:role_id", async (c) => {
     return c.json({ error: "Group to role not found" }, 404);
   }
 
-  return c.json(groupToRole.role);
+  return c.json(
+    serializers.roleAssignment(groupToRole.role, {
+      type: "group",
+      id: group.id,
+    }),
+  );
 });
 
 route.delete("/:role_id", async (c) => {
@@ -4,12 +4,14 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 import { type GroupEnv, requireGroup } from "../middleware/group";
 
 const route = new Hono<GroupEnv>();
 route.use(requireGroup);
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const group = c.get("group");
 
   const users = await db.query.groupsToUsers.findMany({
@@ -25,12 +27,13 @@ route.get("/", async (c) => {
     },
   });
 
-  return c.json({
-    object: "list",
-    data: users.map((groupToUser) => groupToUser.user),
-    has_more: false,
-    next: null,
-  });
+  return c.json(
+    paginate(
+      users.map((groupToUser) => groupToUser.user),
+      c.req.valid("query"),
+      { cursor: (user) => user.id, style: "next" },
+    ),
+  );
 });
 
 route.post(
@@ -77,7 +80,7 @@ route.get("/:user_id", async (c) => {
     return c.json({ error: "Group to user not found" }, 404);
   }
 
-  return c.json(groupToUser.user);
+  return c.json(serializers.groupUser(groupToUser.user));
 });
 
 route.delete("/:user_id", async (c) => {
@@ -4,6 +4,8 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 
 const requestSchema = z.object({ name: z.string() });
 
@@ -11,16 +13,16 @@ const route = new Hono();
 
 route.get(
   "/",
-  zValidator("query", z.object({ limit: z.coerce.number().optional() })),
+  zValidator("query", paginationQuery),
   async (c) => {
     const groups = await db.select().from(schema.groups);
 
-    return c.json({
-      object: "list",
-      data: groups,
-      has_more: false,
-      next: null,
-    });
+    return c.json(
+      paginate(groups.map(serializers.group), c.req.valid("query"), {
+        cursor: (group) => group.id,
+        style: "next",
+      }),
+    );
   },
 );
 
@@ -33,8 +35,11 @@ route.post("/", zValidator("json", requestSchema), async (c) => {
       name,
     })
     .returning();
+  if (!group) {
+    return c.json({ error: "Failed to create group" }, 500);
+  }
 
-  return c.json(group);
+  return c.json(serializers.group(group));
 });
 
 route.get("/:group_id", async (c) => {
@@ -46,7 +51,7 @@ route.get("/:group_id", async (c) => {
     return c.json({ error: "Group not found" }, 404);
   }
 
-  return c.json(group);
+  return c.json(serializers.group(group));
 });
 
 route.post("/:group_id", zValidator("json", requestSchema), async (c) => {
@@ -64,7 +69,8 @@ route.post("/:group_id", zValidator("json", requestSchema), async (c) => {
     return c.json({ error: "Group not found" }, 404);
   }
 
-  return c.json(group);
+  const { group_type: _, ...updatedGroup } = serializers.group(group);
+  return c.json(updatedGroup);
 });
 
 route.delete("/:group_id", async (c) => {
@@ -4,39 +4,49 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 
 const route = new Hono();
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const invites = await db.query.invites.findMany();
 
-  return c.json({
-    object: "list",
-    data: invites,
-    has_more: false,
-    first_id: invites.at(0)?.id,
-    last_id: invites.at(-1)?.id,
-  });
+  return c.json(
+    paginate(invites.map(serializers.invite), c.req.valid("query"), {
+      cursor: (invite) => invite.id,
+    }),
+  );
 });
 
 route.post(
   "/",
   zValidator(
     "json",
-    z.object({ email: z.string(), role: z.enum(["owner", "reader"]) }),
+    z.object({
+      email: z.string(),
+      role: z.enum(["owner", "reader"]),
+      projects: z
+        .array(z.object({ id: z.string(), role: z.enum(["member", "owner"]) }))
+        .optional(),
+    }),
   ),
   async (c) => {
-    const { email, role } = c.req.valid("json");
+    const { email, role, projects } = c.req.valid("json");
 
     const [invite] = await db
       .insert(schema.invites)
       .values({
         email,
         role,
+        projects,
       })
       .returning();
+    if (!invite) {
+      return c.json({ error: "Failed to create invite" }, 500);
+    }
 
-    return c.json(invite);
+    return c.json(serializers.invite(invite));
   },
 );
 
@@ -50,7 +60,7 @@ route.get("/:invite_id", async (c) => {
     return c.json({ error: "Invite not found" }, 404);
   }
 
-  return c.json(invite);
+  return c.json(serializers.invite(invite));
 });
 
 route.delete("/:invite_id", async (c) => {
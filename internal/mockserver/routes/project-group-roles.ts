@@ -4,6 +4,8 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 import { requireGroup, type GroupEnv } from "../middleware/group";
 import { requireProject, type ProjectEnv } from "../middleware/project";
 
@@ -13,7 +15,7 @@ const route = new Hono<{
 route.use(requireProject);
 route.use(requireGroup);
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const project = c.get("project");
   const group = c.get("group");
 
@@ -27,12 +29,15 @@ route.get("/", async (c) => {
     },
   });
 
-  return c.json({
-    object: "list",
-    data: roles.map((role) => role.role),
-    has_more: false,
-    next: null,
-  });
+  return c.json(
+    paginate(
+      roles.map((role) =>
+        serializers.roleAssignment(role.role, { type: "group", id: group.id }),
+      ),
+      c.req.valid("query"),
+      { cursor: (role) => role.id, style: "next" },
+    ),
+  );
 });
 
 route.post(
@@ -58,8 +63,8 @@ route.post(
 
     return c.json({
       object: "group.role",
-      group,
-      role,
+      group: serializers.groupSummary(group),
+      role: serializers.role(role),
     });
   },
 );
@@ -83,7 +88,12 @@ route.get("/:role_id", async (c) => {
     return c.json({ error: "Group to role not found" }, 404);
   }
 
-  return c.json(groupToRole.role);
+  return c.json(
+    serializers.roleAssignment(groupToRole.role, {
+      type: "group",
+      id: group.id,
+    }),
+  );
 });
 
 route.delete("/:role_id", async (c) => {
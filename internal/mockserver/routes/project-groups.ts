@@ -0,0 +1,36 @@
+import { zValidator } from "@hono/zod-validator";
+import { eq } from "drizzle-orm";
+import { Hono } from "hono";
+import { db } from "../db";
+import * as schema from "../db-schema";
+import { type ProjectEnv, requireProject } from "../middleware/project";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
+
+const route = new Hono<ProjectEnv>();
+route.use(requireProject);
+
+route.get("/", zValidator("query", paginationQuery), async (c) => {
+  const project = c.get("project");
+
+  const roles = await db.query.projectsToGroupsToRoles.findMany({
+    where: eq(schema.projectsToGroupsToRoles.project_id, project.id),
+    with: {
+      group: true,
+    },
+  });
+
+  const groups = new Map(roles.map((role) => [role.group.id, role.group]));
+
+  return c.json(
+    paginate(
+      [...groups.values()].map((group) =>
+        serializers.projectGroup(project, group),
+      ),
+      c.req.valid("query"),
+      { cursor: (group) => group.group_id, style: "next" },
+    ),
+  );
+});
+
+export default route;
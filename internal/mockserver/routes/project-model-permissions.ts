@@ -7,6 +7,7 @@ import * as schema from "../db-schema";
 import { buildConflictUpdateColumns } from "../db-utils";
 import type { ProjectEnv } from "../middleware/project";
 import { requireProject } from "../middleware/project";
+import * as serializers from "../serializers";
 
 const route = new Hono<ProjectEnv>();
 route.use(requireProject);
@@ -21,7 +22,7 @@ route.get("/", async (c) => {
     return c.json({ error: "Model permissions not found" }, 404);
   }
 
-  return c.json(modelPermissions);
+  return c.json(serializers.projectModelPermissions(modelPermissions));
 });
 
 route.post(
@@ -56,7 +57,9 @@ route.post(
       return c.json({ error: "Model permissions not found" }, 404);
     }
 
-    return c.json(updatedModelPermissions);
+    return c.json(
+      serializers.projectModelPermissions(updatedModelPermissions),
+    );
   },
 );
 
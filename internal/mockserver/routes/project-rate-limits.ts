@@ -4,25 +4,27 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
 import { requireProject, type ProjectEnv } from "../middleware/project";
+import * as serializers from "../serializers";
 
 const route = new Hono<ProjectEnv>();
 route.use(requireProject);
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const project = c.get("project");
 
   const rate_limits = await db.query.projectRateLimits.findMany({
     where: eq(schema.projectRateLimits.project_id, project.id),
   });
 
-  return c.json({
-    object: "list",
-    data: rate_limits,
-    has_more: false,
-    first_id: rate_limits.at(0)?.id,
-    last_id: rate_limits.at(-1)?.id,
-  });
+  return c.json(
+    paginate(
+      rate_limits.map(serializers.projectRateLimit),
+      c.req.valid("query"),
+      { cursor: (rate_limit) => rate_limit.id },
+    ),
+  );
 });
 
 route.post(
@@ -56,7 +58,7 @@ route.post(
       return c.json({ error: "Rate limit not found" }, 404);
     }
 
-    return c.json(updatedRateLimit);
+    return c.json(serializers.projectRateLimit(updatedRateLimit));
   },
 );
 
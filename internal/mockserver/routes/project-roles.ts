@@ -4,6 +4,8 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 import { type ProjectEnv, requireProject } from "../middleware/project";
 
 const requestSchema = z.object({
@@ -15,7 +17,7 @@ const requestSchema = z.object({
 const route = new Hono<ProjectEnv>();
 route.use(requireProject);
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const project = c.get("project");
 
   const roles = await db.query.projectsToRoles.findMany({
@@ -25,12 +27,13 @@ route.get("/", async (c) => {
     },
   });
 
-  return c.json({
-    object: "list",
-    data: roles.map((role) => role.role),
-    has_more: false,
-    next: null,
-  });
+  return c.json(
+    paginate(
+      roles.map((role) => serializers.role(role.role)),
+      c.req.valid("query"),
+      { cursor: (role) => role.id, style: "next" },
+    ),
+  );
 });
 
 route.post("/", zValidator("json", requestSchema), async (c) => {
@@ -53,7 +56,7 @@ route.post("/", zValidator("json", requestSchema), async (c) => {
     role_id: role!.id,
   });
 
-  return c.json(role);
+  return c.json(serializers.role(role!));
 });
 
 route.get("/:role_id", async (c) => {
@@ -73,7 +76,7 @@ route.get("/:role_id", async (c) => {
     return c.json({ error: "Role not found" }, 404);
   }
 
-  return c.json(role.role);
+  return c.json(serializers.role(role.role));
 });
 
 route.post("/:role_id", zValidator("json", requestSchema), async (c) => {
@@ -101,7 +104,7 @@ route.post("/:role_id", zValidator("json", requestSchema), async (c) => {
     .where(eq(schema.roles.id, projectToRole.role_id))
     .returning();
 
-  return c.json(role);
+  return c.json(serializers.role(role!));
 });
 
 route.delete("/:role_id", async (c) => {
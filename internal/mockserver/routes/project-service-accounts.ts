@@ -5,10 +5,28 @@ import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
 import { type ProjectEnv, requireProject } from "../middleware/project";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 
 const route = new Hono<ProjectEnv>();
 route.use(requireProject);
 
+route.get("/", zValidator("query", paginationQuery), async (c) => {
+  const project = c.get("project");
+
+  const serviceAccounts = await db.query.projectServiceAccounts.findMany({
+    where: eq(schema.projectServiceAccounts.project_id, project.id),
+  });
+
+  return c.json(
+    paginate(
+      serviceAccounts.map(serializers.projectServiceAccount),
+      c.req.valid("query"),
+      { cursor: (serviceAccount) => serviceAccount.id },
+    ),
+  );
+});
+
 route.post(
   "/",
   zValidator("json", z.object({ name: z.string() })),
@@ -38,8 +56,11 @@ route.post(
     }
 
     return c.json({
-      ...service_account,
-      api_key,
+      ...serializers.projectServiceAccount(service_account),
+      api_key: serializers.projectServiceAccountApiKey(
+        api_key,
+        service_account,
+      ),
     });
   },
 );
@@ -58,7 +79,7 @@ route.get("/:service_account_id", async (c) => {
     return c.json({ error: "Service account not found" }, 404);
   }
 
-  return c.json(service_account);
+  return c.json(serializers.projectServiceAccount(service_account));
 });
 
 route.delete("/:service_account_id", async (c) => {
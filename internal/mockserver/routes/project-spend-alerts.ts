@@ -4,8 +4,10 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
 import { buildConflictUpdateColumns } from "../db-utils";
 import { requireProject, type ProjectEnv } from "../middleware/project";
+import * as serializers from "../serializers";
 
 const requestSchema = z.object({
   currency: z.enum(["USD"]),
@@ -21,20 +23,20 @@ const requestSchema = z.object({
 const route = new Hono<ProjectEnv>();
 route.use(requireProject);
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const project = c.get("project");
 
   const spendAlerts = await db.query.projectSpendAlerts.findMany({
     where: eq(schema.projectSpendAlerts.project_id, project.id),
   });
 
-  return c.json({
-    object: "list",
-    data: spendAlerts,
-    has_more: false,
-    first_id: spendAlerts.at(0)?.id,
-    last_id: spendAlerts.at(-1)?.id,
-  });
+  return c.json(
+    paginate(
+      spendAlerts.map(serializers.projectSpendAlert),
+      c.req.valid("query"),
+      { cursor: (spendAlert) => spendAlert.id },
+    ),
+  );
 });
 
 route.post("/", zValidator("json", requestSchema), async (c) => {
@@ -61,8 +63,11 @@ route.post("/", zValidator("json", requestSchema), async (c) => {
       ]),
     })
     .returning();
+  if (!spendAlert) {
+    return c.json({ error: "Failed to create spend alert" }, 500);
+  }
 
-  return c.json(spendAlert);
+  return c.json(serializers.projectSpendAlert(spendAlert));
 });
 
 route.get("/:alert_id", async (c) => {
@@ -75,7 +80,7 @@ route.get("/:alert_id", async (c) => {
     return c.json({ error: "Spend alert not found" }, 404);
   }
 
-  return c.json(spendAlert);
+  return c.json(serializers.projectSpendAlert(spendAlert));
 });
 
 route.post("/:alert_id", zValidator("json", requestSchema), async (c) => {
@@ -103,7 +108,7 @@ route.post("/:alert_id", zValidator("json", requestSchema), async (c) => {
     return c.json({ error: "Spend alert not found" }, 404);
   }
 
-  return c.json(spendAlert);
+  return c.json(serializers.projectSpendAlert(spendAlert));
 });
 
 route.delete("/:alert_id", async (c) => {
@@ -125,6 +130,7 @@ route.delete("/:alert_id", async (c) => {
 
   return c.json({
     object: "project.spend_alert.deleted",
+    id: spendAlert.id,
     deleted: true,
   });
 });
@@ -6,6 +6,7 @@ import { db } from "../db";
 import * as schema from "../db-schema";
 import { buildConflictUpdateColumns } from "../db-utils";
 import { requireProject, type ProjectEnv } from "../middleware/project";
+import * as serializers from "../serializers";
 
 const route = new Hono<ProjectEnv>();
 route.use(requireProject);
@@ -20,7 +21,7 @@ route.get("/", async (c) => {
     return c.json({ error: "Spend limit not found" }, 404);
   }
 
-  return c.json(spendLimit);
+  return c.json(serializers.projectSpendLimit(spendLimit));
 });
 
 route.post(
@@ -59,7 +60,7 @@ route.post(
       })
       .returning();
 
-    return c.json(spendLimit);
+    return c.json(serializers.projectSpendLimit(spendLimit!));
   },
 );
 
@@ -4,6 +4,8 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 import { requireProject, type ProjectEnv } from "../middleware/project";
 
 const route = new Hono<
@@ -13,7 +15,7 @@ const route = new Hono<
 >();
 route.use(requireProject);
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const project = c.get("project");
   const user_id = c.req.param("user_id");
 
@@ -27,12 +29,15 @@ route.get("/", async (c) => {
     },
   });
 
-  return c.json({
-    object: "list",
-    data: roles.map((role) => role.role),
-    has_more: false,
-    next: null,
-  });
+  return c.json(
+    paginate(
+      roles.map((role) =>
+        serializers.roleAssignment(role.role, { type: "user", id: user_id }),
+      ),
+      c.req.valid("query"),
+      { cursor: (role) => role.id, style: "next" },
+    ),
+  );
 });
 
 route.post(
@@ -66,7 +71,7 @@ route.post(
     return c.json({
       object: "user.role",
       user,
-      role,
+      role: serializers.role(role),
     });
   },
 );
@@ -90,7 +95,9 @@ route.get("/:role_id", async (c) => {
     return c.json({ error: "User to role not found" }, 404);
   }
 
-  return c.json(userToRole.role);
+  return c.json(
+    serializers.roleAssignment(userToRole.role, { type: "user", id: user_id }),
+  );
 });
 
 route.delete("/:role_id", async (c) => {
@@ -5,10 +5,33 @@ import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
 import { type ProjectEnv, requireProject } from "../middleware/project";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 
 const route = new Hono<ProjectEnv>();
 route.use(requireProject);
 
+route.get("/", zValidator("query", paginationQuery), async (c) => {
+  const project = c.get("project");
+
+  const projectToUsers = await db.query.projectsToUsers.findMany({
+    where: eq(schema.projectsToUsers.project_id, project.id),
+    with: {
+      user: true,
+    },
+  });
+
+  return c.json(
+    paginate(
+      projectToUsers.map((projectToUser) =>
+        serializers.projectUser(projectToUser, projectToUser.user),
+      ),
+      c.req.valid("query"),
+      { cursor: (user) => user.id },
+    ),
+  );
+});
+
 route.post(
   "/",
   zValidator(
@@ -49,13 +72,7 @@ route.post(
       })
       .onConflictDoNothing();
 
-    return c.json({
-      object: "organization.project.user",
-      id: user.id,
-      email: user.email,
-      role: projectToUser.role,
-      added_at: projectToUser.added_at,
-    });
+    return c.json(serializers.projectUser(projectToUser, user));
   },
 );
 
@@ -76,13 +93,7 @@ route.get("/:user_id", async (c) => {
     return c.json({ error: "User not found" }, 404);
   }
 
-  return c.json({
-    object: "organization.project.user",
-    id: projectToUser.user.id,
-    email: projectToUser.user.email,
-    role: projectToUser.role,
-    added_at: projectToUser.added_at,
-  });
+  return c.json(serializers.projectUser(projectToUser, projectToUser.user));
 });
 
 route.post(
@@ -144,13 +155,9 @@ route.post(
       })
       .onConflictDoNothing();
 
-    return c.json({
-      object: "organization.project.user",
-      id: projectToUser.user.id,
-      email: projectToUser.user.email,
-      role: updatedProjectToUser.role,
-      added_at: updatedProjectToUser.added_at,
-    });
+    return c.json(
+      serializers.projectUser(updatedProjectToUser, projectToUser.user),
+    );
   },
 );
 
@@ -4,8 +4,10 @@ import { Hono } from "hono";
 import z from "zod";
 import { db, insertDefaultProjectRateLimits } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
 import { now } from "../db-utils";
 import { requireProject } from "../middleware/project";
+import * as serializers from "../serializers";
 
 const route = new Hono();
 
@@ -13,10 +15,12 @@ route.get(
   "/",
   zValidator(
     "query",
-    z.object({ include_archived: z.coerce.boolean().default(false) }),
+    paginationQuery.extend({
+      include_archived: z.coerce.boolean().default(false),
+    }),
   ),
   async (c) => {
-    const include_archived = c.req.valid("query").include_archived;
+    const { include_archived, ...query } = c.req.valid("query");
     const projects = await db.query.projects.findMany({
       where: or(
         eq(schema.projects.status, "active"),
@@ -24,13 +28,11 @@ route.get(
       ),
     });
 
-    return c.json({
-      object: "list",
-      data: projects,
-      has_more: false,
-      first_id: projects.at(0)?.id,
-      last_id: projects.at(-1)?.id,
-    });
+    return c.json(
+      paginate(projects.map(serializers.project), query, {
+        cursor: (project) => project.id,
+      }),
+    );
   },
 );
 
@@ -61,14 +63,14 @@ route.post(
 
     await insertDefaultProjectRateLimits({ projectId: project.id });
 
-    return c.json(project);
+    return c.json(serializers.project(project));
   },
 );
 
 route.get("/:project_id", requireProject, async (c) => {
   const project = c.get("project");
 
-  return c.json(project);
+  return c.json(serializers.project(project));
 });
 
 route.post(
@@ -79,26 +81,26 @@ route.post(
     const project = c.get("project");
     const { name } = c.req.valid("json");
 
-    const result = await db
+    const [updatedProject] = await db
       .update(schema.projects)
       .set({ name })
       .where(eq(schema.projects.id, project.id))
       .returning();
 
-    return c.json(result[0]);
+    return c.json(serializers.project(updatedProject!));
   },
 );
 
 route.post("/:project_id/archive", requireProject, async (c) => {
   const project = c.get("project");
 
-  const result = await db
+  const [archivedProject] = await db
     .update(schema.projects)
     .set({ status: "archived", archived_at: now() })
     .where(eq(schema.projects.id, project.id))
     .returning();
 
-  return c.json(result[0]);
+  return c.json(serializers.project(archivedProject!));
 });
 
 export default route;
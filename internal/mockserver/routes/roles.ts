@@ -4,6 +4,8 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 
 const requestSchema = z.object({
   permissions: z.array(z.string()),
@@ -13,17 +15,17 @@ const requestSchema = z.object({
 
 const route = new Hono();
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const roles = await db.query.roles.findMany({
     where: eq(schema.roles.resource_type, "api.organization"),
   });
 
-  return c.json({
-    object: "list",
-    data: roles,
-    has_more: false,
-    next: null,
-  });
+  return c.json(
+    paginate(roles.map(serializers.role), c.req.valid("query"), {
+      cursor: (role) => role.id,
+      style: "next",
+    }),
+  );
 });
 
 route.post("/", zValidator("json", requestSchema), async (c) => {
@@ -38,8 +40,11 @@ route.post("/", zValidator("json", requestSchema), async (c) => {
       resource_type: "api.organization",
     })
     .returning();
+  if (!role) {
+    return c.json({ error: "Failed to create role" }, 500);
+  }
 
-  return c.json(role);
+  return c.json(serializers.role(role));
 });
 
 route.get("/:role_id", async (c) => {
@@ -51,7 +56,7 @@ route.get("/:role_id", async (c) => {
     return c.json({ error: "Role not found" }, 404);
   }
 
-  return c.json(role);
+  return c.json(serializers.role(role));
 });
 
 route.post("/:role_id", zValidator("json", requestSchema), async (c) => {
@@ -76,7 +81,7 @@ route.post("/:role_id", zValidator("json", requestSchema), async (c) => {
     return c.json({ error: "Role not found" }, 404);
   }
 
-  return c.json(updatedRole);
+  return c.json(serializers.role(updatedRole));
 });
 
 route.delete("/:role_id", async (c) => {
@@ -97,6 +102,7 @@ route.delete("/:role_id", async (c) => {
 
   return c.json({
     object: "role.deleted",
+    id: result[0].id,
     deleted: true,
   });
 });
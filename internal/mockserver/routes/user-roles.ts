@@ -4,10 +4,12 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
+import * as serializers from "../serializers";
 
 const route = new Hono<{}, {}, "/organization/users/:user_id/roles">();
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const user_id = c.req.param("user_id");
 
   const roles = await db.query.usersToRoles.findMany({
@@ -17,12 +19,18 @@ route.get("/", async (c) => {
     },
   });
 
-  return c.json({
-    object: "list",
-    data: roles.map((userToRole) => userToRole.role),
-    has_more: false,
-    next: null,
-  });
+  return c.json(
+    paginate(
+      roles.map((userToRole) =>
+        serializers.roleAssignment(userToRole.role, {
+          type: "user",
+          id: user_id,
+        }),
+      ),
+      c.req.valid("query"),
+      { cursor: (role) => role.id, style: "next" },
+    ),
+  );
 });
 
 route.post(
@@ -57,7 +65,7 @@ route.post(
     return c.json({
       object: "user.role",
       user,
-      role,
+      role: serializers.role(role),
     });
   },
 );
@@ -79,7 +87,9 @@ route.get("/:role_id", async (c) => {
     return c.json({ error: "User to role not found" }, 404);
   }
 
-  return c.json(userToRole.role);
+  return c.json(
+    serializers.roleAssignment(userToRole.role, { type: "user", id: user_id }),
+  );
 });
 
 route.delete("/:role_id", async (c) => {
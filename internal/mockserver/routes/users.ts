@@ -4,19 +4,16 @@ import { Hono } from "hono";
 import z from "zod";
 import { db } from "../db";
 import * as schema from "../db-schema";
+import { paginate, paginationQuery } from "../pagination";
 
 const route = new Hono();
 
-route.get("/", async (c) => {
+route.get("/", zValidator("query", paginationQuery), async (c) => {
   const users = await db.query.users.findMany();
 
-  return c.json({
-    object: "list",
-    data: users,
-    has_more: false,
-    first_id: users.at(0)?.id,
-    last_id: users.at(-1)?.id,
-  });
+  return c.json(
+    paginate(users, c.req.valid("query"), { cursor: (user) => user.id }),
+  );
 });
 
 route.get("/:user_id", async (c) => {
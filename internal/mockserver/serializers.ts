@@ -0,0 +1,199 @@
+import * as schema from "./db-schema";
+
+// The serializers shape database rows into the objects returned by the Admin
+// API. Rows must not be returned as is, as they may hold internal columns such
+// as foreign keys, which the contract tests in internal/contract reject.
+
+type AdminApiKey = typeof schema.adminApiKeys.$inferSelect;
+type Group = typeof schema.groups.$inferSelect;
+type Invite = typeof schema.invites.$inferSelect;
+type Project = typeof schema.projects.$inferSelect;
+type ProjectModelPermissions =
+  typeof schema.projectModelPermissions.$inferSelect;
+type ProjectRateLimit = typeof schema.projectRateLimits.$inferSelect;
+type ProjectServiceAccount = typeof schema.projectServiceAccounts.$inferSelect;
+type ProjectServiceAccountApiKey =
+  typeof schema.projectServiceAccountApiKeys.$inferSelect;
+type ProjectSpendAlert = typeof schema.projectSpendAlerts.$inferSelect;
+type ProjectSpendLimit = typeof schema.projectSpendLimits.$inferSelect;
+type ProjectToUser = typeof schema.projectsToUsers.$inferSelect;
+type Role = typeof schema.roles.$inferSelect;
+type User = typeof schema.users.$inferSelect;
+
+export function adminApiKey(apiKey: AdminApiKey, owner: User) {
+  return {
+    object: apiKey.object,
+    id: apiKey.id,
+    name: apiKey.name,
+    redacted_value: `sk-admin-***${apiKey.value.slice(-3)}`,
+    created_at: apiKey.created_at,
+    expires_at: apiKey.expires_at,
+    last_used_at: null,
+    owner: {
+      type: "user",
+      object: owner.object,
+      id: owner.id,
+      name: owner.name,
+      created_at: owner.added_at,
+      role: owner.role,
+    },
+  };
+}
+
+export function group(group: Group) {
+  return {
+    id: group.id,
+    name: group.name,
+    group_type: "group" as const,
+    is_scim_managed: group.is_scim_managed,
+    created_at: group.created_at,
+  };
+}
+
+// groupSummary is the group embedded in the response of a role assignment.
+export function groupSummary(group: Group) {
+  return {
+    object: group.object,
+    id: group.id,
+    name: group.name,
+    scim_managed: group.is_scim_managed,
+    created_at: group.created_at,
+  };
+}
+
+export function groupUser(user: User) {
+  return {
+    id: user.id,
+    name: user.name,
+    email: user.email,
+    picture: null,
+    is_service_account: false,
+    user_type: "user" as const,
+  };
+}
+
+export function invite(invite: Invite) {
+  return {
+    object: invite.object,
+    id: invite.id,
+    email: invite.email,
+    role: invite.role,
+    status: invite.status,
+    projects: invite.projects,
+    created_at: invite.created_at,
+    expires_at: invite.expires_at,
+    accepted_at: invite.accepted_at,
+  };
+}
+
+export function project(project: Project) {
+  return {
+    object: project.object,
+    id: project.id,
+    name: project.name,
+    status: project.status,
+    created_at: project.created_at,
+    archived_at: project.archived_at,
+  };
+}
+
+// projectGroup is a group with access to a project. The mock server has no
+// separate membership table: a group has access to the projects it holds roles
+// in.
+export function projectGroup(project: Project, group: Group) {
+  return {
+    object: "project.group",
+    project_id: project.id,
+    group_id: group.id,
+    group_name: group.name,
+    group_type: "group",
+    created_at: group.created_at,
+  };
+}
+
+export function projectModelPermissions(
+  modelPermissions: ProjectModelPermissions,
+) {
+  const { project_id: _, ...rest } = modelPermissions;
+  return rest;
+}
+
+export function projectRateLimit(rateLimit: ProjectRateLimit) {
+  const { project_id: _, ...rest } = rateLimit;
+  return rest;
+}
+
+export function projectServiceAccount(serviceAccount: ProjectServiceAccount) {
+  const { project_id: _, ...rest } = serviceAccount;
+  return rest;
+}
+
+export function projectServiceAccountApiKey(
+  apiKey: ProjectServiceAccountApiKey,
+  serviceAccount: ProjectServiceAccount,
+) {
+  return {
+    object: apiKey.object,
+    id: apiKey.id,
+    name: serviceAccount.name,
+    value: apiKey.value,
+    created_at: apiKey.created_at,
+  };
+}
+
+export function projectSpendAlert(spendAlert: ProjectSpendAlert) {
+  const { project_id: _, ...rest } = spendAlert;
+  return rest;
+}
+
+export function projectSpendLimit(spendLimit: ProjectSpendLimit) {
+  const { project_id: _, ...rest } = spendLimit;
+  return rest;
+}
+
+export function projectUser(projectToUser: ProjectToUser, user: User) {
+  return {
+    object: "organization.project.user",
+    id: user.id,
+    name: user.name,
+    email: user.email,
+    role: projectToUser.role,
+    added_at: projectToUser.added_at,
+  };
+}
+
+export function role(role: Role) {
+  return {
+    object: role.object,
+    id: role.id,
+    name: role.name,
+    description: role.description,
+    permissions: role.permissions,
+    resource_type: role.resource_type,
+    predefined_role: role.predefined_role,
+  };
+}
+
+// roleAssignment is a role assigned to a principal, as returned by the role
+// assignment endpoints of users and groups.
+export function roleAssignment(
+  role: Role,
+  principal: { type: "user" | "group"; id: string },
+) {
+  return {
+    id: role.id,
+    name: role.name,
+    description: role.description,
+    permissions: role.permissions,
+    resource_type: role.resource_type,
+    predefined_role: role.predefined_role,
+    assignment_sources: [
+      { principal_type: principal.type, principal_id: principal.id },
+    ],
+    created_by: null,
+    created_by_user_obj: null,
+    metadata: null,
+    created_at: role.created_at,
+    updated_at: role.updated_at,
+  };
+}
@@ -1,8 +1,16 @@
 package openaiparam
 
 import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/openai/openai-go/v3/packages/param"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+	"github.com/samber/lo"
 )
 
 func FromString(v supertypes.StringValue) param.Opt[string] {
@@ -18,3 +26,171 @@ func FromInt64(v supertypes.Int64Value) param.Opt[int64] {
 	}
 	return param.Opt[int64]{}
 }
+
+func FromFloat64(v types.Float64) param.Opt[float64] {
+	if !v.IsNull() && !v.IsUnknown() {
+		return param.NewOpt(v.ValueFloat64())
+	}
+	return param.Opt[float64]{}
+}
+
+func FromBool(v supertypes.BoolValue) param.Opt[bool] {
+	if v.IsKnown() {
+		return param.NewOpt(v.ValueBool())
+	}
+	return param.Opt[bool]{}
+}
+
+// FromEnum converts a string value to an enum of the SDK. The value must be
+// one of allowed, the constants of the enum, as the API would otherwise
+// reject it with a less helpful error. Null and unknown values are the zero
+// value, which the SDK omits.
+func FromEnum[E ~string](p path.Path, v supertypes.StringValue, allowed ...E) (E, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !v.IsKnown() {
+		return "", diags
+	}
+
+	e := E(v.ValueString())
+	if !slices.Contains(allowed, e) {
+		diags.AddAttributeError(p, "Invalid Attribute Value", fmt.Sprintf("Attribute %s value must be one of: %q, got: %q", p, allowed, e))
+		return "", diags
+	}
+	return e, diags
+}
+
+// FromList converts a list value to a slice of the SDK. Null and unknown
+// values are nil, which the SDK omits.
+func FromList[T any](ctx context.Context, v supertypes.ListValueOf[T]) ([]T, diag.Diagnostics) {
+	if !v.IsKnown() {
+		return nil, nil
+	}
+	return v.Get(ctx)
+}
+
+// FromSet converts a set value to a slice of the SDK. Null and unknown values
+// are nil, which the SDK omits.
+func FromSet[T any](ctx context.Context, v supertypes.SetValueOf[T]) ([]T, diag.Diagnostics) {
+	if !v.IsKnown() {
+		return nil, nil
+	}
+	return v.Get(ctx)
+}
+
+// FromMap converts a map value to a map of the SDK. Null and unknown values
+// are nil, which the SDK omits.
+func FromMap[T any](ctx context.Context, v supertypes.MapValueOf[T]) (map[string]T, diag.Diagnostics) {
+	if !v.IsKnown() {
+		return nil, nil
+	}
+	return v.Get(ctx)
+}
+
+// FromSingleNested converts a nested object to a params struct of the SDK
+// with convert. Null and unknown objects are the zero value, which the SDK
+// omits, so that an object computed by the API is left to it.
+func FromSingleNested[T, P any](ctx context.Context, v supertypes.SingleNestedObjectValueOf[T], convert func(context.Context, *T) (P, diag.Diagnostics)) (P, diag.Diagnostics) {
+	var zero P
+	if !v.IsKnown() {
+		return zero, nil
+	}
+
+	m, diags := v.Get(ctx)
+	if diags.HasError() {
+		return zero, diags
+	}
+	p, convertDiags := convert(ctx, m)
+	diags.Append(convertDiags...)
+	return p, diags
+}
+
+// FromListNested converts a list of nested objects to a slice of params
+// structs of the SDK with convert. Null and unknown lists are nil.
+func FromListNested[T, P any](ctx context.Context, v supertypes.ListNestedObjectValueOf[T], convert func(context.Context, *T) (P, diag.Diagnostics)) ([]P, diag.Diagnostics) {
+	if !v.IsKnown() {
+		return nil, nil
+	}
+
+	ms, diags := v.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return convertSlice(ctx, ms, convert, diags)
+}
+
+// FromSetNested converts a set of nested objects to a slice of params structs
+// of the SDK with convert. Null and unknown sets are nil.
+func FromSetNested[T, P any](ctx context.Context, v supertypes.SetNestedObjectValueOf[T], convert func(context.Context, *T) (P, diag.Diagnostics)) ([]P, diag.Diagnostics) {
+	if !v.IsKnown() {
+		return nil, nil
+	}
+
+	ms, diags := v.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return convertSlice(ctx, ms, convert, diags)
+}
+
+// FromMapNested converts a map of nested objects to a map of params structs
+// of the SDK with convert. Null and unknown maps are nil.
+func FromMapNested[T, P any](ctx context.Context, v supertypes.MapNestedObjectValueOf[T], convert func(context.Context, *T) (P, diag.Diagnostics)) (map[string]P, diag.Diagnostics) {
+	if !v.IsKnown() {
+		return nil, nil
+	}
+
+	ms, diags := v.Get(ctx)
+	if diags.HasError() {
+		return nil, diags
+	}
+	ps := make(map[string]P, len(ms))
+	for k, m := range ms {
+		p, convertDiags := convert(ctx, m)
+		diags.Append(convertDiags...)
+		ps[k] = p
+	}
+	return ps, diags
+}
+
+func convertSlice[T, P any](ctx context.Context, ms []*T, convert func(context.Context, *T) (P, diag.Diagnostics), diags diag.Diagnostics) ([]P, diag.Diagnostics) {
+	ps := make([]P, 0, len(ms))
+	for _, m := range ms {
+		p, convertDiags := convert(ctx, m)
+		diags.Append(convertDiags...)
+		ps = append(ps, p)
+	}
+	return ps, diags
+}
+
+// String, Int64, Float64 and Bool convert an element of the SDK, which may be
+// an enum, to the element type of a Terraform collection. They are meant to be
+// passed to ToList, ToSet and ToMap.
+
+func String[E ~string](v E) string { return string(v) }
+
+func Int64[E ~int64](v E) int64 { return int64(v) }
+
+func Float64[E ~float64](v E) float64 { return float64(v) }
+
+func Bool[E ~bool](v E) bool { return bool(v) }
+
+// ToList converts a slice of the SDK to a list value.
+func ToList[E, T any](ctx context.Context, s []E, convert func(E) T) supertypes.ListValueOf[T] {
+	return supertypes.NewListValueOfSlice(ctx, lo.Map(s, func(v E, _ int) T {
+		return convert(v)
+	}))
+}
+
+// ToSet converts a slice of the SDK to a set value, dropping duplicates.
+func ToSet[E any, T comparable](ctx context.Context, s []E, convert func(E) T) supertypes.SetValueOf[T] {
+	return supertypes.NewSetValueOfSlice(ctx, lo.Uniq(lo.Map(s, func(v E, _ int) T {
+		return convert(v)
+	})))
+}
+
+// ToMap converts a map of the SDK to a map value.
+func ToMap[E, T any](ctx context.Context, m map[string]E, convert func(E) T) (supertypes.MapValueOf[T], diag.Diagnostics) {
+	return supertypes.NewMapValueOfMap(ctx, lo.MapValues(m, func(v E, _ string) T {
+		return convert(v)
+	}))
+}
@@ -0,0 +1,136 @@
+package openaiparam
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+type role string
+
+func TestToList(t *testing.T) {
+	ctx := t.Context()
+
+	got, diags := ToList(ctx, []role{"owner", "reader", "owner"}, String).Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("Get: %v", diags)
+	}
+	if want := []string{"owner", "reader", "owner"}; !slices.Equal(got, want) {
+		t.Errorf("ToList = %v, want %v", got, want)
+	}
+}
+
+func TestToSet(t *testing.T) {
+	ctx := t.Context()
+
+	got, diags := ToSet(ctx, []int64{1, 2, 1}, Int64).Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("Get: %v", diags)
+	}
+	slices.Sort(got)
+	if want := []int64{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("ToSet = %v, want %v", got, want)
+	}
+}
+
+func TestToMap(t *testing.T) {
+	ctx := t.Context()
+
+	v, diags := ToMap(ctx, map[string]float64{"gpt-4o": 0.5, "o3": 1}, Float64)
+	if diags.HasError() {
+		t.Fatalf("ToMap: %v", diags)
+	}
+	got, diags := v.Get(ctx)
+	if diags.HasError() {
+		t.Fatalf("Get: %v", diags)
+	}
+	if want := map[string]float64{"gpt-4o": 0.5, "o3": 1}; !maps.Equal(got, want) {
+		t.Errorf("ToMap = %v, want %v", got, want)
+	}
+}
+
+func TestFromEnum(t *testing.T) {
+	p := path.Root("role")
+
+	testCases := []struct {
+		name    string
+		value   supertypes.StringValue
+		want    role
+		wantErr bool
+	}{
+		{name: "allowed", value: supertypes.NewStringValue("owner"), want: "owner"},
+		{name: "not allowed", value: supertypes.NewStringValue("admin"), wantErr: true},
+		{name: "null", value: supertypes.NewStringNull()},
+		{name: "unknown", value: supertypes.NewStringUnknown()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := FromEnum(p, tc.value, role("owner"), role("reader"))
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("FromEnum diagnostics = %v, want error %t", diags, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("FromEnum = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFromSet(t *testing.T) {
+	ctx := t.Context()
+
+	got, diags := FromSet(ctx, supertypes.NewSetValueOfSlice(ctx, []string{"api.organization.read"}))
+	if diags.HasError() {
+		t.Fatalf("FromSet: %v", diags)
+	}
+	if want := []string{"api.organization.read"}; !slices.Equal(got, want) {
+		t.Errorf("FromSet = %v, want %v", got, want)
+	}
+
+	got, diags = FromSet(ctx, supertypes.NewSetValueOfUnknown[string](ctx))
+	if diags.HasError() {
+		t.Fatalf("FromSet: %v", diags)
+	}
+	if got != nil {
+		t.Errorf("FromSet of an unknown set = %v, want nil", got)
+	}
+}
+
+type channel struct {
+	Recipients supertypes.SetValueOf[string] `tfsdk:"recipients"`
+}
+
+type channelParams struct {
+	Recipients []string
+}
+
+func TestFromSingleNested(t *testing.T) {
+	ctx := t.Context()
+	convert := func(ctx context.Context, m *channel) (channelParams, diag.Diagnostics) {
+		recipients, diags := FromSet(ctx, m.Recipients)
+		return channelParams{Recipients: recipients}, diags
+	}
+
+	got, diags := FromSingleNested(ctx, supertypes.NewSingleNestedObjectValueOf(ctx, &channel{
+		Recipients: supertypes.NewSetValueOfSlice(ctx, []string{"user@example.com"}),
+	}), convert)
+	if diags.HasError() {
+		t.Fatalf("FromSingleNested: %v", diags)
+	}
+	if want := []string{"user@example.com"}; !slices.Equal(got.Recipients, want) {
+		t.Errorf("FromSingleNested recipients = %v, want %v", got.Recipients, want)
+	}
+
+	got, diags = FromSingleNested(ctx, supertypes.NewSingleNestedObjectValueOfUnknown[channel](ctx), convert)
+	if diags.HasError() {
+		t.Fatalf("FromSingleNested: %v", diags)
+	}
+	if got.Recipients != nil {
+		t.Errorf("FromSingleNested of an unknown object = %+v, want the zero value", got)
+	}
+}
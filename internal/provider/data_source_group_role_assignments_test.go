@@ -13,6 +13,8 @@ import (
 )
 
 func TestAccGroupRoleAssignmentsDataSource(t *testing.T) {
+	group := acctest.Group(t)
+
 	rn := "data.openai_group_role_assignments.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 
@@ -26,7 +28,7 @@ func TestAccGroupRoleAssignmentsDataSource(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config: testAccGroupRoleAssignmentsDataSourceConfig(acctest.TestGroupId, roleName),
+				Config: testAccGroupRoleAssignmentsDataSourceConfig(group.ID, roleName),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("roles"), knownvalue.SetPartial([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
@@ -3,11 +3,11 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 	"github.com/samber/lo"
@@ -86,7 +86,7 @@ func (d *GroupUsersDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	if err := iter.Err(); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Config.Schema, "read", err))
 		return
 	}
 
@@ -12,6 +12,8 @@ import (
 )
 
 func TestAccGroupUsersDataSource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "data.openai_group_users.test"
 	groupName := sdkacctest.RandomWithPrefix("tf-group")
 
@@ -20,12 +22,12 @@ func TestAccGroupUsersDataSource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccGroupUsersDataSourceConfig(groupName, acctest.TestUserId),
+				Config: testAccGroupUsersDataSourceConfig(groupName, user.ID),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("group_id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("users"), knownvalue.SetPartial([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
-							"id":    knownvalue.StringExact(acctest.TestUserId),
+							"id":    knownvalue.StringExact(user.ID),
 							"email": knownvalue.NotNull(),
 							"name":  knownvalue.NotNull(),
 						}),
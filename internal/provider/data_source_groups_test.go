@@ -11,6 +11,8 @@ import (
 )
 
 func TestAccGroupsDataSource(t *testing.T) {
+	group := acctest.Group(t)
+
 	rn := "data.openai_groups.test"
 
 	resource.Test(t, resource.TestCase{
@@ -22,8 +24,8 @@ func TestAccGroupsDataSource(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("groups"), knownvalue.SetPartial([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
-							"id":              knownvalue.StringExact(acctest.TestGroupId),
-							"name":            knownvalue.StringExact("acc-tf-group"),
+							"id":              knownvalue.StringExact(group.ID),
+							"name":            knownvalue.StringExact(group.Name),
 							"is_scim_managed": knownvalue.Bool(false),
 							"created_at":      knownvalue.NotNull(),
 						}),
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+var _ datasource.DataSource = &PermissionsDataSource{}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &PermissionsDataSource{}
+}
+
+// PermissionsDataSource lists the permissions that custom roles may grant. It
+// is hand-written because the permissions come from the predefined roles, or
+// the built-in list when the roles do not list them.
+type PermissionsDataSource struct {
+	baseDataSource
+}
+
+type PermissionsDataSourceModel struct {
+	ResourceType supertypes.StringValue        `tfsdk:"resource_type"`
+	ProjectId    supertypes.StringValue        `tfsdk:"project_id"`
+	Permissions  supertypes.SetValueOf[string] `tfsdk:"permissions"`
+	Source       supertypes.StringValue        `tfsdk:"source"`
+}
+
+func (d *PermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *PermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the permissions that custom roles of a resource type may grant, i.e. the `permissions` of `openai_organization_role` and `openai_project_role`. The permissions are those of the predefined roles, or a built-in list when the predefined roles do not list them.",
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				MarkdownDescription: "Resource type of the roles. `api.organization` or `api.project`.",
+				Required:            true,
+				CustomType:          supertypes.StringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOf(roleResourceTypeOrganization, roleResourceTypeProject),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project whose predefined roles list the permissions. Only valid with `api.project`. Without it, the permissions of project roles are the built-in list.",
+				Optional:            true,
+				CustomType:          supertypes.StringType{},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Permissions that custom roles of the resource type may grant.",
+				Computed:            true,
+				CustomType:          supertypes.NewSetTypeOf[string](ctx),
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Where the permissions come from. `api` for the predefined roles, or `builtin` for the built-in list of the provider, which may lag behind the API.",
+				Computed:            true,
+				CustomType:          supertypes.StringType{},
+			},
+		},
+	}
+}
+
+func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resourceType := data.ResourceType.ValueString()
+	if resourceType != roleResourceTypeProject && data.ProjectId.IsKnown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_id"),
+			"Invalid Attribute Combination",
+			"project_id is only valid when resource_type is \"api.project\".",
+		)
+		return
+	}
+
+	var catalog permissionCatalog
+	var err error
+	switch {
+	case resourceType == roleResourceTypeOrganization:
+		catalog, err = organizationPermissionCatalog(ctx, d.client)
+	case data.ProjectId.IsKnown():
+		catalog, err = projectPermissionCatalog(ctx, d.client, data.ProjectId.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Config.Schema, "read", err))
+		return
+	}
+
+	data.Source = supertypes.NewStringValue("api")
+	if catalog == nil {
+		catalog = builtinPermissionCatalog(resourceType)
+		data.Source = supertypes.NewStringValue("builtin")
+	}
+	data.Permissions = openaiparam.ToSet(ctx, catalog.permissions(), openaiparam.String)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
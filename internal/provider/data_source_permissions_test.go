@@ -0,0 +1,49 @@
+package provider_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-openai/internal/acctest"
+)
+
+func TestAccPermissionsDataSource(t *testing.T) {
+	rn := "data.openai_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsDataSourceConfig("api.organization"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("permissions"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.StringExact("api.groups.read"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("source"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccPermissionsDataSourceConfig("api.project"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("permissions"), knownvalue.SetPartial([]knownvalue.Check{
+						knownvalue.StringExact("api.organization.projects.api_keys.read"),
+					})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("source"), knownvalue.StringExact("builtin")),
+				},
+			},
+		},
+	})
+}
+
+func testAccPermissionsDataSourceConfig(resourceType string) string {
+	return fmt.Sprintf(`
+data "openai_permissions" "test" {
+	resource_type = %[1]q
+}
+`, resourceType)
+}
@@ -12,6 +12,8 @@ import (
 )
 
 func TestAccProjectGroupRoleAssignmentsDataSource(t *testing.T) {
+	group := acctest.Group(t)
+
 	rn := "data.openai_project_group_role_assignments.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 	projectName := sdkacctest.RandomWithPrefix("tf-project")
@@ -26,7 +28,7 @@ func TestAccProjectGroupRoleAssignmentsDataSource(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config: testAccProjectGroupRoleAssignmentsDataSourceConfig(projectName, acctest.TestGroupId, roleName),
+				Config: testAccProjectGroupRoleAssignmentsDataSourceConfig(projectName, group.ID, roleName),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("roles"), knownvalue.SetExact([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
@@ -3,14 +3,14 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
-	"github.com/samber/lo"
 )
 
 var _ datasource.DataSource = &ProjectModelPermissionsDataSource{}
@@ -60,7 +60,7 @@ func (d *ProjectModelPermissionsDataSource) Read(ctx context.Context, req dataso
 
 	modelInstance, err := d.client.Admin.Organization.Projects.ModelPermissions.Get(ctx, data.ProjectId.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Config.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -83,7 +83,7 @@ type ProjectModelPermissionsDataSourceModel struct {
 
 func (m *ProjectModelPermissionsDataSourceModel) Fill(ctx context.Context, data openai.ProjectModelPermissions) (diags diag.Diagnostics) {
 	m.Mode = supertypes.NewStringValue(string(data.Mode))
-	m.ModelIds = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(data.ModelIDs))
+	m.ModelIds = openaiparam.ToSet(ctx, data.ModelIDs, openaiparam.String)
 
 	return
 }
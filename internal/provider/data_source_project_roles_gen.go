@@ -3,11 +3,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 	"github.com/samber/lo"
@@ -101,7 +102,7 @@ func (d *ProjectRolesDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	if err := iter.Err(); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Config.Schema, "read", err))
 		return
 	}
 
@@ -141,7 +142,7 @@ func (m *ProjectRolesDataSourceModelRolesItem) Fill(ctx context.Context, data op
 	m.Id = supertypes.NewStringValue(string(data.ID))
 	m.Name = supertypes.NewStringValue(string(data.Name))
 	m.Description = supertypes.NewStringValue(string(data.Description))
-	m.Permissions = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(data.Permissions))
+	m.Permissions = openaiparam.ToSet(ctx, data.Permissions, openaiparam.String)
 	m.PredefinedRole = supertypes.NewBoolValue(bool(data.PredefinedRole))
 	m.ResourceType = supertypes.NewStringValue(string(data.ResourceType))
 
@@ -12,6 +12,8 @@ import (
 )
 
 func TestAccProjectUserRoleAssignmentsDataSource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "data.openai_project_user_role_assignments.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 	projectName := sdkacctest.RandomWithPrefix("tf-project")
@@ -26,7 +28,7 @@ func TestAccProjectUserRoleAssignmentsDataSource(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config: testAccProjectUserRoleAssignmentsDataSourceConfig(projectName, roleName, acctest.TestUserId),
+				Config: testAccProjectUserRoleAssignmentsDataSourceConfig(projectName, roleName, user.ID),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("roles"), knownvalue.SetExact([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
@@ -12,6 +12,8 @@ import (
 )
 
 func TestAccUserRoleAssignmentsDataSource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "data.openai_user_role_assignments.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 
@@ -25,7 +27,7 @@ func TestAccUserRoleAssignmentsDataSource(t *testing.T) {
 		},
 		Steps: []resource.TestStep{
 			{
-				Config: testAccUserRoleAssignmentsDataSourceConfig(acctest.TestUserId, roleName),
+				Config: testAccUserRoleAssignmentsDataSourceConfig(user.ID, roleName),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("roles"), knownvalue.SetPartial([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
@@ -12,6 +12,8 @@ import (
 )
 
 func TestAccUserDataSource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "data.openai_user.test"
 
 	resource.Test(t, resource.TestCase{
@@ -19,7 +21,7 @@ func TestAccUserDataSource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccUserDataSourceConfig,
+				Config: testAccUserDataSourceConfig(user.ID),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("email"), knownvalue.NotNull()),
@@ -32,8 +34,10 @@ func TestAccUserDataSource(t *testing.T) {
 	})
 }
 
-var testAccUserDataSourceConfig = fmt.Sprintf(`
+func testAccUserDataSourceConfig(userId string) string {
+	return fmt.Sprintf(`
 data "openai_user" "test" {
 	id = %[1]q
 }
-`, acctest.TestUserId)
+`, userId)
+}
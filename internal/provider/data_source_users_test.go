@@ -11,6 +11,8 @@ import (
 )
 
 func TestAccUsersDataSource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "data.openai_users.test"
 
 	resource.Test(t, resource.TestCase{
@@ -22,7 +24,7 @@ func TestAccUsersDataSource(t *testing.T) {
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("users"), knownvalue.SetPartial([]knownvalue.Check{
 						knownvalue.ObjectExact(map[string]knownvalue.Check{
-							"id":       knownvalue.StringExact(acctest.TestUserId),
+							"id":       knownvalue.StringExact(user.ID),
 							"email":    knownvalue.NotNull(),
 							"name":     knownvalue.NotNull(),
 							"role":     knownvalue.NotNull(),
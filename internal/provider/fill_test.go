@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/openai/openai-go/v3"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the Fill tests")
+
+// fillTest runs the Fill method of a model against the fixtures in
+// testdata/fill/{name}.
+type fillTest struct {
+	name string
+	run  func(t *testing.T, name string)
+}
+
+// fillTests returns the Fill tests of the hand-written models. A Fill method
+// that takes the responses of several API methods has a test, and a fixture
+// directory, for each of them.
+func fillTests() []fillTest {
+	return []fillTest{
+		{
+			name: "resource_admin_api_key/create",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewAdminApiKeyResource()), func(ctx context.Context, m *AdminApiKeyResourceModel, data openai.AdminOrganizationAdminAPIKeyNewResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_admin_api_key/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewAdminApiKeyResource()), func(ctx context.Context, m *AdminApiKeyResourceModel, data openai.AdminAPIKey) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_group/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewGroupResource()), func(ctx context.Context, m *GroupResourceModel, data openai.Group) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_group/update",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewGroupResource()), func(ctx context.Context, m *GroupResourceModel, data openai.AdminOrganizationGroupUpdateResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_group_role_assignment/create",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewGroupRoleAssignmentResource()), func(ctx context.Context, m *GroupRoleAssignmentResourceModel, data openai.AdminOrganizationGroupRoleNewResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_group_role_assignment/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewGroupRoleAssignmentResource()), func(ctx context.Context, m *GroupRoleAssignmentResourceModel, data openai.AdminOrganizationGroupRoleGetResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_group_user/create",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewGroupUserResource()), func(ctx context.Context, m *GroupUserResourceModel, data openai.AdminOrganizationGroupUserNewResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_group_user/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewGroupUserResource()), func(ctx context.Context, m *GroupUserResourceModel, data openai.AdminOrganizationGroupUserGetResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_project_group_role_assignment/create",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewProjectGroupRoleAssignmentResource()), func(ctx context.Context, m *ProjectGroupRoleAssignmentResourceModel, data openai.AdminOrganizationProjectGroupRoleNewResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_project_group_role_assignment/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewProjectGroupRoleAssignmentResource()), func(ctx context.Context, m *ProjectGroupRoleAssignmentResourceModel, data openai.AdminOrganizationProjectGroupRoleGetResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_project_service_account/create",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewProjectServiceAccountResource()), func(ctx context.Context, m *ProjectServiceAccountResourceModel, data openai.AdminOrganizationProjectServiceAccountNewResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_project_service_account/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewProjectServiceAccountResource()), func(ctx context.Context, m *ProjectServiceAccountResourceModel, data openai.ProjectServiceAccount) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_project_user_role_assignment/create",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewProjectUserRoleAssignmentResource()), func(ctx context.Context, m *ProjectUserRoleAssignmentResourceModel, data openai.AdminOrganizationProjectUserRoleNewResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_project_user_role_assignment/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewProjectUserRoleAssignmentResource()), func(ctx context.Context, m *ProjectUserRoleAssignmentResourceModel, data openai.AdminOrganizationProjectUserRoleGetResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_user_role",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewUserRoleResource()), func(ctx context.Context, m *UserRoleResourceModel, data openai.OrganizationUser) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_user_role_assignment/create",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewUserRoleAssignmentResource()), func(ctx context.Context, m *UserRoleAssignmentResourceModel, data openai.AdminOrganizationUserRoleNewResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+		{
+			name: "resource_user_role_assignment/read",
+			run: func(t *testing.T, name string) {
+				testFill(t, name, resourceState(t, NewUserRoleAssignmentResource()), func(ctx context.Context, m *UserRoleAssignmentResourceModel, data openai.AdminOrganizationUserRoleGetResponse) diag.Diagnostics {
+					return m.Fill(ctx, data)
+				})
+			},
+		},
+	}
+}
+
+func TestFill(t *testing.T) {
+	for _, tt := range slices.Concat(generatedFillTests(), fillTests()) {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, tt.name)
+		})
+	}
+}
+
+// resourceState returns an empty state with the schema of r.
+func resourceState(t *testing.T, r resource.Resource) tfsdk.State {
+	t.Helper()
+
+	var resp resource.SchemaResponse
+	r.Schema(t.Context(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unable to get the resource schema: %v", resp.Diagnostics)
+	}
+
+	return tfsdk.State{Schema: resp.Schema}
+}
+
+// dataSourceState returns an empty state with the schema of d.
+func dataSourceState(t *testing.T, d datasource.DataSource) tfsdk.State {
+	t.Helper()
+
+	var resp datasource.SchemaResponse
+	d.Schema(t.Context(), datasource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unable to get the data source schema: %v", resp.Diagnostics)
+	}
+
+	return tfsdk.State{Schema: resp.Schema}
+}
+
+// testFill runs fill for every API response fixture in testdata/fill/{name},
+// i.e. every JSON file except the golden files, and compares the resulting
+// state with {fixture}.golden.json. Run the tests with -update to update the
+// golden files.
+func testFill[T any, M any](t *testing.T, name string, state tfsdk.State, fill func(ctx context.Context, m *M, data T) diag.Diagnostics) {
+	t.Helper()
+
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "fill", name, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fixtures = slices.DeleteFunc(fixtures, func(f string) bool {
+		return strings.HasSuffix(f, ".golden.json")
+	})
+	if len(fixtures) == 0 {
+		t.Fatalf("No fixtures found in testdata/fill/%s", name)
+	}
+
+	for _, fixture := range fixtures {
+		t.Run(strings.TrimSuffix(filepath.Base(fixture), ".json"), func(t *testing.T) {
+			ctx := t.Context()
+
+			raw, err := os.ReadFile(fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var data T
+			if err := json.Unmarshal(raw, &data); err != nil {
+				t.Fatalf("Unable to unmarshal fixture: %s", err)
+			}
+
+			var m M
+			if diags := fill(ctx, &m, data); diags.HasError() {
+				t.Fatalf("Fill returned errors: %v", diags)
+			}
+
+			state := state
+			if diags := state.Set(ctx, &m); diags.HasError() {
+				t.Fatalf("Unable to set state: %v", diags)
+			}
+
+			got, err := json.MarshalIndent(goldenValue(t, state.Raw), "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+
+			goldenFile := strings.TrimSuffix(fixture, ".json") + ".golden.json"
+			if *updateGolden {
+				if err := os.WriteFile(goldenFile, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(goldenFile)
+			if err != nil {
+				t.Fatalf("Unable to read golden file, run the tests with -update to create it: %s", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("State does not match %s, run the tests with -update to update it.\ngot:\n%s\nwant:\n%s", goldenFile, got, want)
+			}
+		})
+	}
+}
+
+// goldenValue converts v to a value that can be marshalled to JSON. Null
+// values are marshalled to null and unknown values to "<unknown>".
+func goldenValue(t *testing.T, v tftypes.Value) any {
+	t.Helper()
+
+	if v.IsNull() {
+		return nil
+	}
+	if !v.IsKnown() {
+		return "<unknown>"
+	}
+
+	typ := v.Type()
+	switch {
+	case typ.Is(tftypes.String):
+		var s string
+		mustAs(t, v, &s)
+		return s
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		mustAs(t, v, &f)
+		return json.Number(f.Text('f', -1))
+	case typ.Is(tftypes.Bool):
+		var b bool
+		mustAs(t, v, &b)
+		return b
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		mustAs(t, v, &elems)
+		out := make([]any, len(elems))
+		for i, elem := range elems {
+			out[i] = goldenValue(t, elem)
+		}
+		return out
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+		mustAs(t, v, &attrs)
+		out := make(map[string]any, len(attrs))
+		for k, attr := range attrs {
+			out[k] = goldenValue(t, attr)
+		}
+		return out
+	default:
+		t.Fatalf("Unsupported type %s", typ)
+		return nil
+	}
+}
+
+func mustAs(t *testing.T, v tftypes.Value, dst any) {
+	t.Helper()
+
+	if err := v.As(dst); err != nil {
+		t.Fatal(err)
+	}
+}
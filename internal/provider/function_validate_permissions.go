@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ValidatePermissionsFunction{}
+
+func NewValidatePermissionsFunction() function.Function {
+	return &ValidatePermissionsFunction{}
+}
+
+type ValidatePermissionsFunction struct{}
+
+func (f *ValidatePermissionsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_permissions"
+}
+
+func (f *ValidatePermissionsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Validate the permissions of a custom role",
+		Description: "Returns the permissions that custom roles of the resource type may not grant, in order. Functions cannot call the API, so the permissions are checked against the built-in list of the provider, a snapshot taken on " + builtinPermissionsDate + " of the permissions of the predefined roles returned by the roles endpoints of the Admin API, unless the permissions of the `openai_permissions` data source are given as catalogs.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "resource_type",
+				Description: "Resource type of the role. `api.organization` or `api.project`.",
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(roleResourceTypeOrganization, roleResourceTypeProject),
+				},
+			},
+			function.ListParameter{
+				Name:        "permissions",
+				Description: "The permissions to validate.",
+				ElementType: types.StringType,
+			},
+		},
+		VariadicParameter: function.SetParameter{
+			Name:        "catalogs",
+			Description: "Valid permissions to check against instead of the built-in list, e.g. the `permissions` of the `openai_permissions` data source.",
+			ElementType: types.StringType,
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ValidatePermissionsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType string
+	var permissions []string
+	var catalogs [][]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &resourceType, &permissions, &catalogs))
+	if resp.Error != nil {
+		return
+	}
+
+	catalog := builtinPermissionCatalog(resourceType)
+	if len(catalogs) > 0 {
+		catalog = make(permissionCatalog)
+		for _, permissions := range catalogs {
+			for _, permission := range permissions {
+				catalog[permission] = struct{}{}
+			}
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, catalog.invalid(permissions)))
+}
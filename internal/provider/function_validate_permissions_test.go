@@ -0,0 +1,115 @@
+package provider_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jianyuan/terraform-provider-openai/internal/acctest"
+)
+
+func TestValidatePermissionsFunction_Builtin(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::openai::validate_permissions("api.organization", ["api.groups.read", "api.groups.reed", "api.model.request"])
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("api.groups.reed"),
+							knownvalue.StringExact("api.model.request"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestValidatePermissionsFunction_Catalogs(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::openai::validate_permissions("api.project", ["api.model.request", "api.custom.read", "api.custom.write"], ["api.model.request"], ["api.custom.read"])
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("api.custom.write"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestValidatePermissionsFunction_Null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::openai::validate_permissions("api.organization", null)
+				}
+				`,
+				// The parameter does not enable AllowNullValue
+				ExpectError: regexp.MustCompile(`argument must not be null`),
+			},
+		},
+	})
+}
+
+func TestValidatePermissionsFunction_Unknown(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "terraform_data" "permissions" {
+					input = ["api.groups.read", "api.groups.reed"]
+				}
+				
+				output "test" {
+					value = provider::openai::validate_permissions("api.organization", terraform_data.permissions.output)
+				}
+				`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue(
+						"test",
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("api.groups.reed"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
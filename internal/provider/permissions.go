@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openai/openai-go/v3"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+// permissionCatalog is the set of permissions that the custom roles of a
+// resource type may grant. The API has no endpoint listing them, so the
+// catalog is made of the permissions of the predefined roles, which together
+// grant every permission of their resource type.
+type permissionCatalog map[string]struct{}
+
+// newPermissionCatalog returns the catalog of the predefined roles, or nil if
+// it is unknown because there is no predefined role, or one of them does not
+// list its permissions or grants every permission with "*".
+func newPermissionCatalog(roles []openai.Role) permissionCatalog {
+	var catalog permissionCatalog
+	for _, role := range roles {
+		if !role.PredefinedRole {
+			continue
+		}
+		if len(role.Permissions) == 0 || slices.Contains(role.Permissions, "*") {
+			return nil
+		}
+		if catalog == nil {
+			catalog = make(permissionCatalog)
+		}
+		for _, permission := range role.Permissions {
+			catalog[permission] = struct{}{}
+		}
+	}
+	return catalog
+}
+
+// organizationRoles returns the roles of the organization. Role lists are
+// served by the API cache of the client, so the roles are only fetched once
+// per run.
+func organizationRoles(ctx context.Context, client *openai.Client) ([]openai.Role, error) {
+	iter := client.Admin.Organization.Roles.ListAutoPaging(ctx, openai.AdminOrganizationRoleListParams{
+		Limit: openai.Int(100),
+	})
+
+	var roles []openai.Role
+	for iter.Next() {
+		roles = append(roles, iter.Current())
+	}
+	return roles, iter.Err()
+}
+
+// projectRoles returns the roles of a project.
+func projectRoles(ctx context.Context, client *openai.Client, projectId string) ([]openai.Role, error) {
+	iter := client.Admin.Organization.Projects.Roles.ListAutoPaging(ctx, projectId, openai.AdminOrganizationProjectRoleListParams{
+		Limit: openai.Int(100),
+	})
+
+	var roles []openai.Role
+	for iter.Next() {
+		roles = append(roles, iter.Current())
+	}
+	return roles, iter.Err()
+}
+
+// organizationPermissionCatalog returns the catalog of organization roles.
+func organizationPermissionCatalog(ctx context.Context, client *openai.Client) (permissionCatalog, error) {
+	roles, err := organizationRoles(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return newPermissionCatalog(roles), nil
+}
+
+// projectPermissionCatalog returns the catalog of the roles of a project.
+func projectPermissionCatalog(ctx context.Context, client *openai.Client, projectId string) (permissionCatalog, error) {
+	roles, err := projectRoles(ctx, client, projectId)
+	if err != nil {
+		return nil, err
+	}
+	return newPermissionCatalog(roles), nil
+}
+
+// roleKind names the roles of a resource type in errors, e.g. "organization"
+// for "api.organization".
+func roleKind(resourceType string) string {
+	return strings.TrimPrefix(resourceType, "api.")
+}
+
+// permissions returns the sorted permissions of the catalog.
+func (c permissionCatalog) permissions() []string {
+	return slices.Sorted(maps.Keys(c))
+}
+
+// invalid returns the permissions that are not in the catalog, in order.
+func (c permissionCatalog) invalid(permissions []string) []string {
+	invalid := []string{}
+	for _, permission := range permissions {
+		if _, ok := c[permission]; !ok {
+			invalid = append(invalid, permission)
+		}
+	}
+	return invalid
+}
+
+// validate returns an attribute error for every known permission that is not
+// in the catalog. kind names the roles in the error, e.g. "organization".
+func (c permissionCatalog) validate(ctx context.Context, p path.Path, permissions supertypes.SetValueOf[string], kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if c == nil || !permissions.IsKnown() {
+		return diags
+	}
+
+	for _, element := range permissions.Elements() {
+		valuable, ok := element.(basetypes.StringValuable)
+		if !ok {
+			continue
+		}
+		permission, d := valuable.ToStringValue(ctx)
+		diags.Append(d...)
+		if permission.IsNull() || permission.IsUnknown() {
+			continue
+		}
+		if _, ok := c[permission.ValueString()]; ok {
+			continue
+		}
+
+		detail := fmt.Sprintf("Permission %q is not available to %s roles.", permission.ValueString(), kind)
+		if suggestion := c.closest(permission.ValueString()); suggestion != "" {
+			detail += fmt.Sprintf(" Did you mean %q?", suggestion)
+		}
+		diags.AddAttributeError(p.AtSetValue(element), "Invalid Permission", detail)
+	}
+	return diags
+}
+
+// closest returns the permission of the catalog closest to the given one, if
+// it is close enough to be a typo.
+func (c permissionCatalog) closest(permission string) string {
+	const maxDistance = 3
+
+	var closest string
+	best := maxDistance + 1
+	for candidate := range c {
+		d := editDistance(permission, candidate)
+		if d < best || d == best && candidate < closest {
+			closest, best = candidate, d
+		}
+	}
+	if best > maxDistance {
+		return ""
+	}
+	return closest
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	row := make([]int, len(b)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			prev, row[j] = row[j], min(row[j]+1, row[j-1]+1, prev+cost)
+		}
+	}
+	return row[len(b)]
+}
+
+// modifyPlanPermissions validates the planned permissions of a custom role
+// against the catalog returned by getCatalog. When the predefined roles do not
+// list their permissions, e.g. because they grant every permission with "*",
+// the built-in permissions of the resource type are used instead, so that a
+// wildcard does not accept every permission. The plan is left alone when the
+// provider is not configured, the role is destroyed, or the catalog cannot be
+// fetched, e.g. because the admin key may not list roles.
+func modifyPlanPermissions(ctx context.Context, client *openai.Client, plan tfsdk.Plan, resourceType string, getCatalog func() (permissionCatalog, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || plan.Raw.IsNull() {
+		return diags
+	}
+
+	var permissions supertypes.SetValueOf[string]
+	diags.Append(plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	if diags.HasError() || !permissions.IsKnown() {
+		return diags
+	}
+
+	catalog, err := getCatalog()
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list roles, skipping validation of permissions", map[string]any{
+			"error": err.Error(),
+		})
+		return diags
+	}
+	if catalog == nil {
+		catalog = builtinPermissionCatalog(resourceType)
+	}
+
+	diags.Append(catalog.validate(ctx, path.Root("permissions"), permissions, roleKind(resourceType))...)
+	return diags
+}
+
+// validateRole returns an attribute error if the role is not the name of one
+// of the predefined roles, which are the roles that members and invites take.
+// Nothing is validated when the role is unknown or there is no predefined
+// role.
+func validateRole(p path.Path, role supertypes.StringValue, roles []openai.Role, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !role.IsKnown() {
+		return diags
+	}
+
+	var names []string
+	for _, r := range roles {
+		if r.PredefinedRole {
+			names = append(names, r.Name)
+		}
+	}
+	if len(names) == 0 || slices.Contains(names, role.ValueString()) {
+		return diags
+	}
+
+	slices.Sort(names)
+	names = slices.Compact(names)
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	diags.AddAttributeError(p, "Invalid Role", fmt.Sprintf("Role %q is not a predefined %s role. Available roles: %s.", role.ValueString(), kind, strings.Join(quoted, ", ")))
+	return diags
+}
+
+// modifyPlanRole validates the planned role of a member or invite against the
+// roles returned by getRoles. As with modifyPlanPermissions, the plan is left
+// alone when the provider is not configured, the resource is destroyed, or
+// the roles cannot be listed.
+func modifyPlanRole(ctx context.Context, client *openai.Client, plan tfsdk.Plan, resourceType string, getRoles func() ([]openai.Role, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || plan.Raw.IsNull() {
+		return diags
+	}
+
+	var role supertypes.StringValue
+	diags.Append(plan.GetAttribute(ctx, path.Root("role"), &role)...)
+	if diags.HasError() || !role.IsKnown() {
+		return diags
+	}
+
+	roles, err := getRoles()
+	if err != nil {
+		tflog.Warn(ctx, "Unable to list roles, skipping validation of the role", map[string]any{
+			"error": err.Error(),
+		})
+		return diags
+	}
+
+	diags.Append(validateRole(path.Root("role"), role, roles, roleKind(resourceType))...)
+	return diags
+}
@@ -0,0 +1,77 @@
+package provider
+
+// Resource types of roles.
+const (
+	roleResourceTypeOrganization = "api.organization"
+	roleResourceTypeProject      = "api.project"
+)
+
+// builtinPermissionsDate is when builtinPermissions were taken from the API.
+const builtinPermissionsDate = "2026-10-19"
+
+// builtinPermissions are the permissions of custom roles by resource type, for
+// when the predefined roles of the organization do not list them. They mirror
+// the permissions of the predefined roles returned by the roles endpoints of
+// the Admin API, GET /organization/roles and
+// GET /organization/projects/{project_id}/roles, which is what the
+// openai_permissions data source reports with source "api". Refresh the list
+// from there when it changes, together with builtinPermissionsDate and the
+// predefined roles of the mock server in
+// internal/mockserver/fixtures/predefined-roles.json, which the tests check the
+// list against. It is a snapshot and may lag behind the API, so the
+// permissions of the predefined roles take precedence.
+var builtinPermissions = map[string][]string{
+	roleResourceTypeOrganization: {
+		"api.groups.read",
+		"api.groups.write",
+		"api.organization.admin_api_keys.read",
+		"api.organization.admin_api_keys.write",
+		"api.organization.audit_logs.read",
+		"api.organization.billing.read",
+		"api.organization.billing.write",
+		"api.organization.invites.read",
+		"api.organization.invites.write",
+		"api.organization.projects.read",
+		"api.organization.projects.write",
+		"api.organization.read",
+		"api.organization.roles.read",
+		"api.organization.roles.write",
+		"api.organization.usage.read",
+		"api.organization.users.read",
+		"api.organization.users.write",
+	},
+	roleResourceTypeProject: {
+		"api.assistants.read",
+		"api.assistants.write",
+		"api.batch.read",
+		"api.batch.write",
+		"api.files.read",
+		"api.files.write",
+		"api.fine_tuning.jobs.read",
+		"api.fine_tuning.jobs.write",
+		"api.model.read",
+		"api.model.request",
+		"api.organization.projects.api_keys.read",
+		"api.organization.projects.api_keys.write",
+		"api.organization.projects.rate_limits.read",
+		"api.organization.projects.rate_limits.write",
+		"api.organization.projects.service_accounts.read",
+		"api.organization.projects.service_accounts.write",
+		"api.organization.projects.users.read",
+		"api.organization.projects.users.write",
+		"api.threads.read",
+		"api.threads.write",
+		"api.vector_stores.read",
+		"api.vector_stores.write",
+	},
+}
+
+// builtinPermissionCatalog returns the catalog of the built-in permissions of
+// the resource type.
+func builtinPermissionCatalog(resourceType string) permissionCatalog {
+	catalog := make(permissionCatalog)
+	for _, permission := range builtinPermissions[resourceType] {
+		catalog[permission] = struct{}{}
+	}
+	return catalog
+}
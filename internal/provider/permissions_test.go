@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/openai/openai-go/v3"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
+)
+
+func TestNewPermissionCatalog(t *testing.T) {
+	owner := openai.Role{Name: "owner", PredefinedRole: true, Permissions: []string{"api.groups.read", "api.groups.write"}}
+	reader := openai.Role{Name: "reader", PredefinedRole: true, Permissions: []string{"api.groups.read", "api.organization.read"}}
+	custom := openai.Role{Name: "custom", Permissions: []string{"api.custom.read"}}
+	wildcard := openai.Role{Name: "owner", PredefinedRole: true, Permissions: []string{"*"}}
+	unlisted := openai.Role{Name: "owner", PredefinedRole: true, Permissions: []string{}}
+
+	catalog := newPermissionCatalog([]openai.Role{owner, reader, custom})
+	if len(catalog) != 3 {
+		t.Errorf("catalog = %v, want the 3 permissions of the predefined roles", catalog)
+	}
+	if _, ok := catalog["api.custom.read"]; ok {
+		t.Errorf("catalog = %v, want no permissions of custom roles", catalog)
+	}
+
+	if catalog := newPermissionCatalog([]openai.Role{custom}); catalog != nil {
+		t.Errorf("catalog without predefined roles = %v, want nil", catalog)
+	}
+	if catalog := newPermissionCatalog([]openai.Role{owner, wildcard}); catalog != nil {
+		t.Errorf("catalog with a wildcard = %v, want nil", catalog)
+	}
+	if catalog := newPermissionCatalog([]openai.Role{owner, unlisted}); catalog != nil {
+		t.Errorf("catalog with a predefined role without permissions = %v, want nil", catalog)
+	}
+}
+
+func TestPermissionCatalog_Validate(t *testing.T) {
+	ctx := t.Context()
+	catalog := permissionCatalog{"api.groups.read": {}, "api.groups.write": {}}
+	p := path.Root("permissions")
+
+	diags := catalog.validate(ctx, p, supertypes.NewSetValueOfSlice(ctx, []string{"api.groups.read", "api.groups.reed", "api.unknown"}), "organization")
+	if got := diags.ErrorsCount(); got != 2 {
+		t.Fatalf("validate errors = %d, want 2: %v", got, diags)
+	}
+
+	for _, d := range diags {
+		withPath, ok := d.(interface{ Path() path.Path })
+		if !ok {
+			t.Fatalf("diagnostic %v has no attribute path", d)
+		}
+		if withPath.Path().Equal(p.AtSetValue(supertypes.NewStringValue("api.groups.reed"))) {
+			if want := `Permission "api.groups.reed" is not available to organization roles. Did you mean "api.groups.read"?`; d.Detail() != want {
+				t.Errorf("detail = %q, want %q", d.Detail(), want)
+			}
+		} else if !withPath.Path().Equal(p.AtSetValue(supertypes.NewStringValue("api.unknown"))) {
+			t.Errorf("unexpected diagnostic path %s", withPath.Path())
+		} else if want := `Permission "api.unknown" is not available to organization roles.`; d.Detail() != want {
+			t.Errorf("detail = %q, want %q", d.Detail(), want)
+		}
+	}
+
+	if diags := permissionCatalog(nil).validate(ctx, p, supertypes.NewSetValueOfSlice(ctx, []string{"api.unknown"}), "organization"); diags.HasError() {
+		t.Errorf("validate with an unknown catalog = %v, want no errors", diags)
+	}
+	if diags := catalog.validate(ctx, p, supertypes.NewSetValueOfUnknown[string](ctx), "organization"); diags.HasError() {
+		t.Errorf("validate of unknown permissions = %v, want no errors", diags)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "abc", want: 3},
+		{a: "api.groups.read", b: "api.groups.read", want: 0},
+		{a: "api.groups.reed", b: "api.groups.read", want: 1},
+		{a: "api.group.read", b: "api.groups.read", want: 1},
+		{a: "kitten", b: "sitting", want: 3},
+	}
+	for _, tc := range testCases {
+		if got := editDistance(tc.a, tc.b); got != tc.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestPermissionCatalog_Invalid(t *testing.T) {
+	catalog := permissionCatalog{"api.groups.read": {}, "api.groups.write": {}}
+
+	got := catalog.invalid([]string{"api.unknown", "api.groups.read", "api.groups.reed"})
+	if want := []string{"api.unknown", "api.groups.reed"}; !slices.Equal(got, want) {
+		t.Errorf("invalid = %q, want %q", got, want)
+	}
+	if got := catalog.invalid(nil); got == nil || len(got) != 0 {
+		t.Errorf("invalid of no permissions = %#v, want an empty slice", got)
+	}
+}
+
+func TestBuiltinPermissionCatalog(t *testing.T) {
+	for _, resourceType := range []string{roleResourceTypeOrganization, roleResourceTypeProject} {
+		permissions := builtinPermissions[resourceType]
+		if len(permissions) == 0 {
+			t.Errorf("builtinPermissions[%q] is empty", resourceType)
+		}
+		if !slices.IsSorted(permissions) {
+			t.Errorf("builtinPermissions[%q] is not sorted", resourceType)
+		}
+		if got := builtinPermissionCatalog(resourceType).permissions(); !slices.Equal(got, permissions) {
+			t.Errorf("builtinPermissionCatalog(%q) = %q, want %q", resourceType, got, permissions)
+		}
+	}
+
+	if catalog := builtinPermissionCatalog("api.unknown"); len(catalog) != 0 {
+		t.Errorf("builtinPermissionCatalog of an unknown resource type = %v, want empty", catalog)
+	}
+}
+
+func TestModifyPlanPermissions_BuiltinFallback(t *testing.T) {
+	ctx := t.Context()
+	client := new(openai.NewClient())
+
+	state := resourceState(t, NewOrganizationRoleResource())
+	plan := tfsdk.Plan{Schema: state.Schema}
+	if diags := plan.Set(ctx, &OrganizationRoleResourceModel{
+		Permissions: supertypes.NewSetValueOfSlice(ctx, []string{"api.groups.read", "api.groups.reed"}),
+	}); diags.HasError() {
+		t.Fatalf("Unable to set plan: %v", diags)
+	}
+
+	// A catalog that is unknown, e.g. because the predefined roles grant "*",
+	// falls back to the built-in permissions instead of accepting everything.
+	diags := modifyPlanPermissions(ctx, client, plan, roleResourceTypeOrganization, func() (permissionCatalog, error) {
+		return nil, nil
+	})
+	if got := diags.ErrorsCount(); got != 1 {
+		t.Fatalf("errors = %d, want 1: %v", got, diags)
+	}
+	if want := `Permission "api.groups.reed" is not available to organization roles. Did you mean "api.groups.read"?`; diags[0].Detail() != want {
+		t.Errorf("detail = %q, want %q", diags[0].Detail(), want)
+	}
+
+	diags = modifyPlanPermissions(ctx, client, plan, roleResourceTypeOrganization, func() (permissionCatalog, error) {
+		return nil, errors.New("forbidden")
+	})
+	if diags.HasError() {
+		t.Errorf("errors when the roles cannot be listed = %v, want none", diags)
+	}
+}
+
+func TestValidateRole(t *testing.T) {
+	roles := []openai.Role{
+		{Name: "owner", PredefinedRole: true},
+		{Name: "reader", PredefinedRole: true},
+		{Name: "billing", PredefinedRole: false},
+	}
+	p := path.Root("role")
+
+	testCases := []struct {
+		name  string
+		role  supertypes.StringValue
+		roles []openai.Role
+		want  string
+	}{
+		{name: "predefined", role: supertypes.NewStringValue("reader"), roles: roles},
+		{name: "unknown role", role: supertypes.NewStringValue("admin"), roles: roles, want: `Role "admin" is not a predefined organization role. Available roles: "owner", "reader".`},
+		{name: "custom role", role: supertypes.NewStringValue("billing"), roles: roles, want: `Role "billing" is not a predefined organization role. Available roles: "owner", "reader".`},
+		{name: "no predefined roles", role: supertypes.NewStringValue("admin"), roles: roles[2:]},
+		{name: "unknown value", role: supertypes.NewStringUnknown(), roles: roles},
+		{name: "null value", role: supertypes.NewStringNull(), roles: roles},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateRole(p, tc.role, tc.roles, "organization")
+			if tc.want == "" {
+				if diags.HasError() {
+					t.Errorf("validateRole = %v, want no errors", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("validateRole = %v, want 1 error", diags)
+			}
+			if diags[0].Detail() != tc.want {
+				t.Errorf("detail = %q, want %q", diags[0].Detail(), tc.want)
+			}
+		})
+	}
+}
+
+// TestBuiltinPermissions_MockServer checks the built-in permissions against
+// the permissions of the predefined roles of the mock server, so that the two
+// snapshots cannot drift apart unnoticed.
+func TestBuiltinPermissions_MockServer(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("..", "mockserver", "fixtures", "predefined-roles.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var roles []openai.Role
+	if err := json.Unmarshal(raw, &roles); err != nil {
+		t.Fatalf("Unable to unmarshal the predefined roles: %s", err)
+	}
+
+	for _, resourceType := range []string{roleResourceTypeOrganization, roleResourceTypeProject} {
+		var predefined []openai.Role
+		for _, role := range roles {
+			if role.ResourceType == resourceType {
+				role.PredefinedRole = true
+				predefined = append(predefined, role)
+			}
+		}
+
+		catalog := newPermissionCatalog(predefined)
+		if catalog == nil {
+			t.Errorf("The predefined %s roles of the mock server do not list their permissions", roleKind(resourceType))
+			continue
+		}
+		if got, want := catalog.permissions(), builtinPermissions[resourceType]; !slices.Equal(got, want) {
+			t.Errorf("The predefined %s roles of the mock server grant %q, but builtinPermissions has %q. Update both from the API and set builtinPermissionsDate.", roleKind(resourceType), got, want)
+		}
+	}
+}
@@ -5,13 +5,19 @@ import (
 	"fmt"
 	"os"
 	"strconv"
-	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jianyuan/terraform-provider-openai/internal/adminkey"
+	"github.com/jianyuan/terraform-provider-openai/internal/apicache"
+	"github.com/jianyuan/terraform-provider-openai/internal/httpclient"
+	"github.com/jianyuan/terraform-provider-openai/internal/readonly"
 	tflog "github.com/jianyuan/terraform-provider-openai/internal/tflog"
 	"github.com/openai/openai-go/v3"
 	"github.com/openai/openai-go/v3/option"
@@ -27,14 +33,27 @@ type OpenAIProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// clientOptions are appended to the options of the API client, e.g. to
+	// intercept requests in acceptance tests.
+	clientOptions []option.RequestOption
 }
 
 // OpenAIProviderModel describes the provider data model.
 type OpenAIProviderModel struct {
 	BaseUrl               types.String `tfsdk:"base_url"`
 	AdminKey              types.String `tfsdk:"admin_key"`
+	AdminKeyFile          types.String `tfsdk:"admin_key_file"`
+	AdminKeyCommand       types.String `tfsdk:"admin_key_command"`
 	MaxRetries            types.Int64  `tfsdk:"max_retries"`
 	RequestTimeoutSeconds types.Int64  `tfsdk:"request_timeout_seconds"`
+	ProxyUrl              types.String `tfsdk:"proxy_url"`
+	CaBundleFile          types.String `tfsdk:"ca_bundle_file"`
+	CaBundlePem           types.String `tfsdk:"ca_bundle_pem"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
 }
 
 func (p *OpenAIProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -54,6 +73,20 @@ func (p *OpenAIProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "The OpenAI admin key can be obtained through the [API Platform Organization](https://platform.openai.com/settings/organization/admin-keys) overview page. It can also be set using the `OPENAI_ADMIN_KEY` environment variable. Note that the admin key must begin with `sk-admin-`.",
 				Optional:            true,
 				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("admin_key_file"), path.MatchRoot("admin_key_command")),
+				},
+			},
+			"admin_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the OpenAI admin key. Surrounding whitespace is ignored. It can also be set using the `OPENAI_ADMIN_KEY_FILE` environment variable. Conflicts with `admin_key` and `admin_key_command`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("admin_key_command")),
+				},
+			},
+			"admin_key_command": schema.StringAttribute{
+				MarkdownDescription: "Shell command that prints the OpenAI admin key as JSON, e.g. `{\"admin_key\": \"sk-admin-...\", \"expires_at\": \"2025-01-01T00:00:00Z\"}`. `expires_at` is optional and must be in RFC 3339 format; when set, the command is run again shortly before the key expires. It can also be set using the `OPENAI_ADMIN_KEY_COMMAND` environment variable. Conflicts with `admin_key` and `admin_key_file`.",
+				Optional:            true,
 			},
 			"max_retries": schema.Int64Attribute{
 				MarkdownDescription: "Maximum number of retries for failed requests. It can also be set using the `OPENAI_MAX_RETRIES` environment variable. Defaults to `3` retries.",
@@ -63,6 +96,41 @@ func (p *OpenAIProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				MarkdownDescription: "Timeout for each request in seconds. It can also be set using the `OPENAI_REQUEST_TIMEOUT_SECONDS` environment variable. Defaults to `60` seconds.",
 				Optional:            true,
 			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send requests through, e.g. `http://proxy.example.com:3128`. It can also be set using the `OPENAI_PROXY_URL` environment variable. Defaults to the proxy configured by the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing PEM-encoded CA certificates to trust in addition to the system roots, e.g. for a TLS-intercepting proxy. It can also be set using the `OPENAI_CA_BUNDLE_FILE` environment variable.",
+				Optional:            true,
+			},
+			"ca_bundle_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificates to trust in addition to the system roots. It can also be set using the `OPENAI_CA_BUNDLE_PEM` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate for mutual TLS. Must be set together with `client_key`. It can also be set using the `OPENAI_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of the client certificate for mutual TLS. Must be set together with `client_certificate`. It can also be set using the `OPENAI_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate")),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Prevent the provider from making any changes to the organization. Data sources and refreshes work as usual, but every create, update and delete fails before the request is sent. Useful for drift detection with a privileged admin key. It can also be set using the `OPENAI_READ_ONLY` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable verification of the server's TLS certificate. **This is insecure and should only be used for testing.** It can also be set using the `OPENAI_INSECURE_SKIP_VERIFY` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -86,16 +154,40 @@ func (p *OpenAIProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		baseUrl = "https://api.openai.com/v1"
 	}
 
-	var adminKey string
+	var adminKey, adminKeyFile, adminKeyCommand string
 	if !data.AdminKey.IsNull() {
 		adminKey = data.AdminKey.ValueString()
+	} else if !data.AdminKeyFile.IsNull() {
+		adminKeyFile = data.AdminKeyFile.ValueString()
+	} else if !data.AdminKeyCommand.IsNull() {
+		adminKeyCommand = data.AdminKeyCommand.ValueString()
 	} else if v := os.Getenv("OPENAI_ADMIN_KEY"); v != "" {
 		adminKey = v
+	} else if v := os.Getenv("OPENAI_ADMIN_KEY_FILE"); v != "" {
+		adminKeyFile = v
+	} else if v := os.Getenv("OPENAI_ADMIN_KEY_COMMAND"); v != "" {
+		adminKeyCommand = v
+	}
+
+	var adminKeySource *adminkey.CommandSource
+	if adminKeyFile != "" {
+		adminKey, err = adminkey.FromFile(adminKeyFile)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid admin_key_file", err.Error())
+			return
+		}
+	} else if adminKeyCommand != "" {
+		adminKeySource = adminkey.NewCommandSource(adminKeyCommand)
+		adminKey, err = adminKeySource.AdminKey(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid admin_key_command", err.Error())
+			return
+		}
 	}
 
 	if adminKey == "" {
 		resp.Diagnostics.AddWarning("admin_key is required", "admin_key is required")
-	} else if !strings.HasPrefix(adminKey, "sk-admin-") {
+	} else if adminkey.Validate(adminKey) != nil {
 		resp.Diagnostics.AddError("admin_key must start with 'sk-admin-'", "admin_key must start with 'sk-admin-'")
 		return
 	}
@@ -122,30 +214,108 @@ func (p *OpenAIProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
-	client := new(openai.NewClient(
+	httpClientConfig := httpclient.Config{
+		ProxyURL:          stringValueOrEnv(data.ProxyUrl, "OPENAI_PROXY_URL"),
+		CABundleFile:      stringValueOrEnv(data.CaBundleFile, "OPENAI_CA_BUNDLE_FILE"),
+		CABundlePEM:       stringValueOrEnv(data.CaBundlePem, "OPENAI_CA_BUNDLE_PEM"),
+		ClientCertificate: stringValueOrEnv(data.ClientCertificate, "OPENAI_CLIENT_CERTIFICATE"),
+		ClientKey:         stringValueOrEnv(data.ClientKey, "OPENAI_CLIENT_KEY"),
+	}
+
+	if !data.InsecureSkipVerify.IsNull() {
+		httpClientConfig.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	} else if v := os.Getenv("OPENAI_INSECURE_SKIP_VERIFY"); v != "" {
+		httpClientConfig.InsecureSkipVerify, err = strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid insecure_skip_verify", "insecure_skip_verify must be a boolean")
+			return
+		}
+	}
+
+	if httpClientConfig.InsecureSkipVerify {
+		resp.Diagnostics.AddWarning(
+			"TLS certificate verification is disabled",
+			"insecure_skip_verify is enabled, so the provider will not verify the identity of the OpenAI API server. "+
+				"Your admin key and organization data may be exposed to anyone able to intercept the connection. "+
+				"Do not use this setting outside of testing.",
+		)
+	}
+
+	readOnly := false
+	if !data.ReadOnly.IsNull() {
+		readOnly = data.ReadOnly.ValueBool()
+	} else if v := os.Getenv("OPENAI_READ_ONLY"); v != "" {
+		readOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid read_only", "read_only must be a boolean")
+			return
+		}
+	}
+
+	opts := []option.RequestOption{
 		option.WithBaseURL(baseUrl),
 		option.WithAdminAPIKey(adminKey),
 		option.WithHeader("User-Agent", fmt.Sprintf("Terraform/%s (+https://www.terraform.io) terraform-provider-openai/%s", req.TerraformVersion, p.version)),
 		option.WithMaxRetries(maxRetries),
-		option.WithRequestTimeout(time.Duration(requestTimeoutSeconds)*time.Second),
+		option.WithRequestTimeout(time.Duration(requestTimeoutSeconds) * time.Second),
+	}
+	if !httpClientConfig.IsZero() {
+		httpClient, err := httpclient.New(httpClientConfig)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to create HTTP client", err.Error())
+			return
+		}
+		opts = append(opts, option.WithHTTPClient(httpClient))
+	}
+	if readOnly {
+		opts = append(opts, option.WithMiddleware(readonly.Middleware))
+	}
+	if adminKeySource != nil {
+		opts = append(opts, option.WithMiddleware(adminKeySource.Middleware))
+	}
+	opts = append(opts,
+		option.WithMiddleware(apicache.New().Middleware),
 		option.WithDebugLog(tflog.StandardLogger(ctx)),
-	))
+	)
+	opts = append(opts, p.clientOptions...)
+
+	client := new(openai.NewClient(opts...))
 
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &resourceData{
+		client:   client,
+		readOnly: readOnly,
+	}
+}
+
+// stringValueOrEnv returns the configured value of v, falling back to the
+// environment variable key.
+func stringValueOrEnv(v types.String, key string) string {
+	if !v.IsNull() {
+		return v.ValueString()
+	}
+	return os.Getenv(key)
 }
 
 func (p *OpenAIProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewPredefinedProjectRoleIdFunction,
 		NewPredefinedRoleIdFunction,
+		NewValidatePermissionsFunction,
 	}
 }
 
 func New(version string) func() provider.Provider {
+	return NewWithClientOptions(version)
+}
+
+// NewWithClientOptions is like New, but appends opts to the options of the API
+// client configured by the provider.
+func NewWithClientOptions(version string, opts ...option.RequestOption) func() provider.Provider {
 	return func() provider.Provider {
 		return &OpenAIProvider{
-			version: version,
+			version:       version,
+			clientOptions: opts,
 		}
 	}
 }
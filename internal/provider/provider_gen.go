@@ -42,6 +42,7 @@ func (p *OpenAIProvider) DataSources(ctx context.Context) []func() datasource.Da
 		NewInviteDataSource,
 		NewInvitesDataSource,
 		NewOrganizationRolesDataSource,
+		NewPermissionsDataSource,
 		NewProjectDataSource,
 		NewProjectGroupRoleAssignmentsDataSource,
 		NewProjectModelPermissionsDataSource,
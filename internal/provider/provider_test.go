@@ -0,0 +1,227 @@
+package provider_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jianyuan/terraform-provider-openai/internal/acctest"
+)
+
+func TestAccProvider_RetryOnRateLimit(t *testing.T) {
+	acctest.PreCheckMockServer(t)
+
+	rn := "openai_project.test"
+	projectName := sdkacctest.RandomWithPrefix("tf-project")
+
+	acctest.InjectFault(t, acctest.FaultRule{
+		Method: "POST",
+		Path:   `^/organization/projects$`,
+		Count:  2,
+		Fault: acctest.Fault{
+			Type:       "status",
+			Status:     429,
+			RetryAfter: new(1),
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(3, 60) + testAccProjectResourceConfig(projectName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(projectName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProvider_RetryOnServerError(t *testing.T) {
+	acctest.PreCheckMockServer(t)
+
+	rn := "openai_project.test"
+	projectName := sdkacctest.RandomWithPrefix("tf-project")
+
+	acctest.InjectFault(t, acctest.FaultRule{
+		Method: "POST",
+		Path:   `^/organization/projects$`,
+		Count:  1,
+		Fault: acctest.Fault{
+			Type:   "status",
+			Status: 503,
+		},
+	})
+	acctest.InjectFault(t, acctest.FaultRule{
+		Method: "GET",
+		Path:   `^/organization/projects/[^/]+$`,
+		Count:  1,
+		Fault: acctest.Fault{
+			Type: "drop",
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(3, 60) + testAccProjectResourceConfig(projectName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(projectName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProvider_MaxRetriesExhausted(t *testing.T) {
+	acctest.PreCheckMockServer(t)
+
+	projectName := sdkacctest.RandomWithPrefix("tf-project")
+
+	acctest.InjectFault(t, acctest.FaultRule{
+		Method: "POST",
+		Path:   `^/organization/projects$`,
+		Fault: acctest.Fault{
+			Type:   "status",
+			Status: 500,
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(1, 60) + testAccProjectResourceConfig(projectName),
+				ExpectError: regexp.MustCompile(`API Error \(500\)`),
+			},
+		},
+	})
+}
+
+// TestAccProvider_RequestTimeout checks that a request that times out is
+// retried. The mock server still creates the project of the timed out request,
+// which is left for the sweepers, as it would be by the API.
+func TestAccProvider_RequestTimeout(t *testing.T) {
+	acctest.PreCheckMockServer(t)
+
+	rn := "openai_project.test"
+	projectName := sdkacctest.RandomWithPrefix("tf-project")
+
+	acctest.InjectFault(t, acctest.FaultRule{
+		Method: "POST",
+		Path:   `^/organization/projects$`,
+		Count:  1,
+		Fault: acctest.Fault{
+			Type:    "delay",
+			DelayMs: 3000,
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(2, 1) + testAccProjectResourceConfig(projectName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(projectName)),
+				},
+			},
+		},
+	})
+}
+
+// TestAccProvider_ReadAfterCreate checks that an object that is not yet
+// visible to reads right after it was created is not dropped from the state,
+// as Read retries it for a while.
+func TestAccProvider_ReadAfterCreate(t *testing.T) {
+	acctest.PreCheckMockServer(t)
+
+	rn := "openai_project.test"
+	projectName := sdkacctest.RandomWithPrefix("tf-project")
+
+	acctest.InjectFault(t, acctest.FaultRule{
+		Method: "POST",
+		Path:   `^/organization/projects$`,
+		Count:  1,
+		Fault: acctest.Fault{
+			Type:           "not_visible",
+			HiddenRequests: 2,
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(0, 60) + testAccProjectResourceConfig(projectName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(projectName)),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// TestAccProvider_SlowResponse checks that a response slower than usual but
+// within the request timeout is used as it is.
+func TestAccProvider_SlowResponse(t *testing.T) {
+	acctest.PreCheckMockServer(t)
+
+	rn := "openai_project.test"
+	projectName := sdkacctest.RandomWithPrefix("tf-project")
+
+	acctest.InjectFault(t, acctest.FaultRule{
+		Method: "POST",
+		Path:   `^/organization/projects$`,
+		Count:  1,
+		Fault: acctest.Fault{
+			Type:    "delay",
+			DelayMs: 2000,
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(0, 60) + testAccProjectResourceConfig(projectName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("name"), knownvalue.StringExact(projectName)),
+				},
+			},
+		},
+	})
+}
+
+func testAccProviderConfig(maxRetries, requestTimeoutSeconds int) string {
+	return fmt.Sprintf(`
+provider "openai" {
+	max_retries             = %[1]d
+	request_timeout_seconds = %[2]d
+}
+`, maxRetries, requestTimeoutSeconds)
+}
@@ -2,14 +2,28 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jianyuan/terraform-provider-openai/internal/readonly"
 	"github.com/openai/openai-go/v3"
 )
 
+// resourceData is the ResourceData of the provider.
+type resourceData struct {
+	client   *openai.Client
+	readOnly bool
+}
+
 type baseResource struct {
-	client *openai.Client
+	client   *openai.Client
+	readOnly bool
 }
 
 func (r *baseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -18,16 +32,143 @@ func (r *baseResource) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*openai.Client)
-
-	if !ok {
+	switch data := req.ProviderData.(type) {
+	case *resourceData:
+		r.client = data.client
+		r.readOnly = data.readOnly
+	case *openai.Client:
+		// Resources configured outside of the provider, e.g. by the sweepers,
+		// only get a client.
+		r.client = data
+	default:
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
 			fmt.Sprintf("Expected *openai.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
+	}
+}
 
-		return
+// refuseReadOnly adds an error and returns true if the provider is in
+// read-only mode. It guards the operations that change the Terraform state
+// without sending a request for the read-only middleware to reject, e.g. a
+// Delete that only removes the resource from the state.
+func (r *baseResource) refuseReadOnly(diags *diag.Diagnostics, action string) bool {
+	if !r.readOnly {
+		return false
+	}
+	diags.AddError("Read-Only Mode", readonly.Message(action))
+	return true
+}
+
+// originalPrivateKey is the private state key holding the API object that a
+// resource with the reset_to_defaults delete strategy read before it was
+// created.
+const originalPrivateKey = "original"
+
+// privateState is the private state of a resource, i.e. the Private field of
+// the framework's requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setOriginal stores the API object read before the resource was created, so
+// that Delete can restore it.
+func setOriginal(ctx context.Context, private privateState, original interface{ RawJSON() string }) diag.Diagnostics {
+	raw := original.RawJSON()
+	if raw == "" {
+		return nil
+	}
+	return private.SetKey(ctx, originalPrivateKey, []byte(raw))
+}
+
+// getOriginal returns the API object stored by setOriginal, or nil if it is
+// unknown because the resource was imported or created by an older version of
+// the provider.
+func getOriginal[T any](ctx context.Context, private privateState) (*T, diag.Diagnostics) {
+	raw, diags := private.GetKey(ctx, originalPrivateKey)
+	if diags.HasError() || len(raw) == 0 {
+		return nil, diags
+	}
+
+	var original T
+	if err := json.Unmarshal(raw, &original); err != nil {
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to decode the settings from before the resource was created: %s", err))
+		return nil, diags
+	}
+	return &original, diags
+}
+
+// createdPrivateKey is the private state key holding the time a resource was
+// created, so that Read can wait for the object to become visible.
+const createdPrivateKey = "created"
+
+// readAfterCreateTimeout bounds how long after Create a Read retries an object
+// that is not found, as the API is eventually consistent and may not serve an
+// object it has just created.
+var readAfterCreateTimeout = time.Minute
+
+// setCreated records in the private state that the resource was just created.
+func setCreated(ctx context.Context, private privateState) diag.Diagnostics {
+	raw, err := json.Marshal(time.Now().Unix())
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Invalid Private State", fmt.Sprintf("Unable to encode the creation time: %s", err))
+		return diags
+	}
+	return private.SetKey(ctx, createdPrivateKey, raw)
+}
+
+// readAfterCreate retries the reads of a resource that find nothing, until
+// readAfterCreateTimeout has passed since the resource was created.
+type readAfterCreate struct {
+	deadline time.Time
+	delay    time.Duration
+}
+
+// newReadAfterCreate returns the retries of a Read. Resources without a
+// creation time, e.g. imported ones or those created by an older version of
+// the provider, are not retried.
+func newReadAfterCreate(ctx context.Context, private privateState) *readAfterCreate {
+	w := &readAfterCreate{delay: time.Second}
+
+	raw, diags := private.GetKey(ctx, createdPrivateKey)
+	if diags.HasError() || len(raw) == 0 {
+		return w
 	}
 
-	r.client = client
+	var created int64
+	if err := json.Unmarshal(raw, &created); err == nil {
+		w.deadline = time.Unix(created, 0).Add(readAfterCreateTimeout)
+	}
+	return w
+}
+
+// retry waits before the next read and returns true if the last read did not
+// find the object and the deadline allows for another one.
+func (w *readAfterCreate) retry(ctx context.Context, notFound bool) bool {
+	if !notFound || time.Now().Add(w.delay).After(w.deadline) {
+		return false
+	}
+
+	tflog.Debug(ctx, "Object not found shortly after it was created, retrying", map[string]any{
+		"delay": w.delay.String(),
+	})
+
+	timer := time.NewTimer(w.delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+	}
+
+	w.delay = min(2*w.delay, 8*time.Second)
+	return true
+}
+
+// isNotFound reports whether err is a 404 returned by the API.
+func isNotFound(err error) bool {
+	apiErr, ok := errors.AsType[*openai.Error](err)
+	return ok && apiErr.StatusCode == http.StatusNotFound
 }
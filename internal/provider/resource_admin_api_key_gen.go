@@ -4,14 +4,15 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
@@ -87,7 +88,7 @@ func (r *AdminApiKeyResource) Create(ctx context.Context, req resource.CreateReq
 
 	modelInstance, err := r.client.Admin.Organization.AdminAPIKeys.New(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -100,6 +101,11 @@ func (r *AdminApiKeyResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *AdminApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -110,14 +116,18 @@ func (r *AdminApiKeyResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.AdminAPIKeys.Get(ctx, data.Id.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.AdminAPIKeys.Get(ctx, data.Id.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -150,11 +160,18 @@ func (r *AdminApiKeyResource) Delete(ctx context.Context, req resource.DeleteReq
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *AdminApiKeyResource) getNewParams(ctx context.Context, data AdminApiKeyResourceModel) (*openai.AdminOrganizationAdminAPIKeyNewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationAdminAPIKeyNewParams{
+		Name: data.Name.ValueString(),
+	}, diags
+}
+
 type AdminApiKeyResourceModel struct {
 	Name      supertypes.StringValue `tfsdk:"name"`
 	Id        supertypes.StringValue `tfsdk:"id"`
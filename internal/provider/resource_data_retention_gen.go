@@ -4,14 +4,16 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
@@ -32,7 +34,7 @@ func (r *DataRetentionResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *DataRetentionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Updates organization data retention controls.",
+		MarkdownDescription: "Updates organization data retention controls. Destroying the resource restores the retention type from before it was created, if known.",
 		Attributes: map[string]schema.Attribute{
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The desired organization data retention type. Must be one of `zero_data_retention`, `enhanced_zero_data_retention`, `modified_abuse_monitoring`, or `enhanced_modified_abuse_monitoring`.",
@@ -54,6 +56,18 @@ func (r *DataRetentionResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// The settings read before the resource is created are restored by Delete.
+	original, err := r.readOriginal(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "read", err))
+		return
+	} else if original != nil {
+		resp.Diagnostics.Append(setOriginal(ctx, resp.Private, original)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	body, diags := r.getNewParams(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -62,7 +76,7 @@ func (r *DataRetentionResource) Create(ctx context.Context, req resource.CreateR
 
 	modelInstance, err := r.client.Admin.Organization.DataRetention.Update(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -75,6 +89,11 @@ func (r *DataRetentionResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *DataRetentionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -85,14 +104,18 @@ func (r *DataRetentionResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.DataRetention.Get(ctx)
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.DataRetention.Get(ctx)
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -123,7 +146,7 @@ func (r *DataRetentionResource) Update(ctx context.Context, req resource.UpdateR
 
 	modelInstance, err := r.client.Admin.Organization.DataRetention.Update(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -139,7 +162,61 @@ func (r *DataRetentionResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *DataRetentionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddWarning("Not Supported", "Delete is not supported for this resource. Please manually delete the resource.")
+	var data DataRetentionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	original, diags := getOriginal[openai.OrganizationDataRetention](ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.getResetParams(ctx, data, original)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if body == nil {
+		if r.refuseReadOnly(&resp.Diagnostics, "remove the resource from the Terraform state without resetting its settings") {
+			return
+		}
+
+		resp.Diagnostics.AddWarning("Settings Not Reset", "The settings from before this resource was created are unknown, e.g. because it was imported, and there is no documented default to reset them to. The resource was removed from the Terraform state and the settings were left as they are.")
+		return
+	}
+
+	_, err := r.client.Admin.Organization.DataRetention.Update(ctx, *body)
+	if err != nil {
+		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
+		return
+	}
+}
+
+// readOriginal reads the settings that Delete restores.
+func (r *DataRetentionResource) readOriginal(ctx context.Context, data DataRetentionResourceModel) (*openai.OrganizationDataRetention, error) {
+	modelInstance, err := r.client.Admin.Organization.DataRetention.Get(ctx)
+	if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return modelInstance, err
+}
+
+func (r *DataRetentionResource) getNewParams(ctx context.Context, data DataRetentionResourceModel) (*openai.AdminOrganizationDataRetentionUpdateParams, diag.Diagnostics) {
+	return r.getUpdateParams(ctx, data)
+}
+
+func (r *DataRetentionResource) getUpdateParams(ctx context.Context, data DataRetentionResourceModel) (*openai.AdminOrganizationDataRetentionUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationDataRetentionUpdateParams{
+		RetentionType: mergeDiagnostics(openaiparam.FromEnum(path.Root("type"), data.Type, openai.AdminOrganizationDataRetentionUpdateParamsRetentionTypeZeroDataRetention, openai.AdminOrganizationDataRetentionUpdateParamsRetentionTypeModifiedAbuseMonitoring, openai.AdminOrganizationDataRetentionUpdateParamsRetentionTypeEnhancedZeroDataRetention, openai.AdminOrganizationDataRetentionUpdateParamsRetentionTypeEnhancedModifiedAbuseMonitoring))(&diags),
+	}, diags
 }
 
 type DataRetentionResourceModel struct {
@@ -7,12 +7,13 @@ import (
 	"github.com/openai/openai-go/v3"
 )
 
-func (r *DataRetentionResource) getNewParams(ctx context.Context, data DataRetentionResourceModel) (*openai.AdminOrganizationDataRetentionUpdateParams, diag.Diagnostics) {
-	return r.getUpdateParams(ctx, data)
-}
-
-func (r *DataRetentionResource) getUpdateParams(ctx context.Context, data DataRetentionResourceModel) (*openai.AdminOrganizationDataRetentionUpdateParams, diag.Diagnostics) {
+// getResetParams restores the original retention type. There is no documented
+// default, so the retention type is left as it is when the original is unknown.
+func (r *DataRetentionResource) getResetParams(ctx context.Context, data DataRetentionResourceModel, original *openai.OrganizationDataRetention) (*openai.AdminOrganizationDataRetentionUpdateParams, diag.Diagnostics) {
+	if original == nil {
+		return nil, nil
+	}
 	return &openai.AdminOrganizationDataRetentionUpdateParams{
-		RetentionType: openai.AdminOrganizationDataRetentionUpdateParamsRetentionType(data.Type.ValueString()),
+		RetentionType: openai.AdminOrganizationDataRetentionUpdateParamsRetentionType(original.Type),
 	}, nil
 }
@@ -4,14 +4,15 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
@@ -73,7 +74,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	modelInstance, err := r.client.Admin.Organization.Groups.New(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -86,6 +87,11 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -96,14 +102,18 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Groups.Get(ctx, data.Id.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Groups.Get(ctx, data.Id.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -134,7 +144,7 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	modelInstance, err := r.client.Admin.Organization.Groups.Update(ctx, data.Id.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -163,11 +173,25 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *GroupResource) getNewParams(ctx context.Context, data GroupResourceModel) (*openai.AdminOrganizationGroupNewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationGroupNewParams{
+		Name: data.Name.ValueString(),
+	}, diags
+}
+
+func (r *GroupResource) getUpdateParams(ctx context.Context, data GroupResourceModel) (*openai.AdminOrganizationGroupUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationGroupUpdateParams{
+		Name: data.Name.ValueString(),
+	}, diags
+}
+
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -24,9 +24,3 @@ func (m *GroupRoleAssignmentResourceModel) Fill(ctx context.Context, data any) d
 		return diags
 	}
 }
-
-func (r *GroupRoleAssignmentResource) getNewParams(ctx context.Context, data GroupRoleAssignmentResourceModel) (*openai.AdminOrganizationGroupRoleNewParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationGroupRoleNewParams{
-		RoleID: data.RoleId.ValueString(),
-	}, nil
-}
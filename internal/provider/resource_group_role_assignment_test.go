@@ -15,6 +15,8 @@ import (
 )
 
 func TestAccGroupRoleAssignmentResource(t *testing.T) {
+	group := acctest.Group(t)
+
 	rn := "openai_group_role_assignment.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 
@@ -23,9 +25,9 @@ func TestAccGroupRoleAssignmentResource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccGroupRoleAssignmentResourceConfig(acctest.TestGroupId, roleName),
+				Config: testAccGroupRoleAssignmentResourceConfig(group.ID, roleName),
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("group_id"), knownvalue.StringExact(acctest.TestGroupId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("group_id"), knownvalue.StringExact(group.ID)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role_id"), knownvalue.NotNull()),
 				},
 			},
@@ -39,7 +41,7 @@ func TestAccGroupRoleAssignmentResource(t *testing.T) {
 					}
 					groupId := rs.Primary.Attributes["group_id"]
 					roleId := rs.Primary.Attributes["role_id"]
-					return tfutils.BuildTwoPartId(groupId, roleId), nil
+					return tfutils.NewCompositeID("group_id", "role_id").Build(groupId, roleId), nil
 				},
 			},
 		},
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
 	"github.com/jianyuan/terraform-provider-openai/internal/tfutils"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
@@ -72,7 +74,7 @@ func (r *GroupUserResource) Create(ctx context.Context, req resource.CreateReque
 
 	modelInstance, err := r.client.Admin.Organization.Groups.Users.New(ctx, data.GroupId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -85,6 +87,11 @@ func (r *GroupUserResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *GroupUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -95,14 +102,18 @@ func (r *GroupUserResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Groups.Users.Get(ctx, data.GroupId.ValueString(), data.UserId.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Groups.Users.Get(ctx, data.GroupId.ValueString(), data.UserId.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -135,24 +146,29 @@ func (r *GroupUserResource) Delete(ctx context.Context, req resource.DeleteReque
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *GroupUserResource) getNewParams(ctx context.Context, data GroupUserResourceModel) (*openai.AdminOrganizationGroupUserNewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationGroupUserNewParams{
+		UserID: data.UserId.ValueString(),
+	}, diags
+}
+
 func (r *GroupUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	first, second, err := tfutils.SplitTwoPartId(req.ID, "group_id", "user_id")
+	id := tfutils.NewCompositeID("group_id", "user_id")
+	parts, err := id.Parse(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("group_id"), first,
-	)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("user_id"), second,
-	)...)
+	for i, name := range id.Names() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
+	}
 }
 
 type GroupUserResourceModel struct {
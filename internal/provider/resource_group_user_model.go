@@ -24,9 +24,3 @@ func (m *GroupUserResourceModel) Fill(ctx context.Context, data any) diag.Diagno
 		return diags
 	}
 }
-
-func (r *GroupUserResource) getNewParams(ctx context.Context, data GroupUserResourceModel) (*openai.AdminOrganizationGroupUserNewParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationGroupUserNewParams{
-		UserID: data.UserId.ValueString(),
-	}, nil
-}
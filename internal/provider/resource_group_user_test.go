@@ -15,6 +15,8 @@ import (
 )
 
 func TestAccGroupUserResource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "openai_group_user.test"
 	groupName := sdkacctest.RandomWithPrefix("tf-group")
 
@@ -23,10 +25,10 @@ func TestAccGroupUserResource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccGroupUserResourceConfig(groupName, acctest.TestUserId),
+				Config: testAccGroupUserResourceConfig(groupName, user.ID),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("group_id"), knownvalue.NotNull()),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(acctest.TestUserId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(user.ID)),
 				},
 			},
 			{
@@ -39,7 +41,7 @@ func TestAccGroupUserResource(t *testing.T) {
 					}
 					groupId := rs.Primary.Attributes["group_id"]
 					userId := rs.Primary.Attributes["user_id"]
-					return tfutils.BuildTwoPartId(groupId, userId), nil
+					return tfutils.NewCompositeID("group_id", "user_id").Build(groupId, userId), nil
 				},
 			},
 		},
@@ -3,13 +3,17 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/openai/openai-go/v3"
 )
 
-func (r *InviteResource) getNewParams(ctx context.Context, data InviteResourceModel) (*openai.AdminOrganizationInviteNewParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationInviteNewParams{
-		Email: data.Email.ValueString(),
-		Role:  openai.AdminOrganizationInviteNewParamsRole(data.Role.ValueString()),
-	}, nil
+var _ resource.ResourceWithModifyPlan = &InviteResource{}
+
+// ModifyPlan fails the plan when the invite is for a role that is not one of
+// the predefined roles of the organization, instead of leaving it to the API
+// during apply.
+func (r *InviteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(modifyPlanRole(ctx, r.client, req.Plan, roleResourceTypeOrganization, func() ([]openai.Role, error) {
+		return organizationRoles(ctx, r.client)
+	})...)
 }
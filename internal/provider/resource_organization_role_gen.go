@@ -4,7 +4,6 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -13,9 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
-	"github.com/samber/lo"
 )
 
 var _ resource.Resource = &OrganizationRoleResource{}
@@ -56,7 +56,7 @@ func (r *OrganizationRoleResource) Schema(ctx context.Context, req resource.Sche
 				CustomType:          supertypes.StringType{},
 			},
 			"permissions": schema.SetAttribute{
-				MarkdownDescription: "Permissions to grant to the role.",
+				MarkdownDescription: "Permissions to grant to the role, as listed by the `openai_permissions` data source. Permissions that no predefined organization role grants fail the plan.",
 				Required:            true,
 				CustomType:          supertypes.NewSetTypeOf[string](ctx),
 			},
@@ -80,7 +80,7 @@ func (r *OrganizationRoleResource) Create(ctx context.Context, req resource.Crea
 
 	modelInstance, err := r.client.Admin.Organization.Roles.New(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -93,6 +93,11 @@ func (r *OrganizationRoleResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *OrganizationRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -103,14 +108,18 @@ func (r *OrganizationRoleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Roles.Get(ctx, data.Id.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Roles.Get(ctx, data.Id.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -141,7 +150,7 @@ func (r *OrganizationRoleResource) Update(ctx context.Context, req resource.Upda
 
 	modelInstance, err := r.client.Admin.Organization.Roles.Update(ctx, data.Id.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -170,11 +179,29 @@ func (r *OrganizationRoleResource) Delete(ctx context.Context, req resource.Dele
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *OrganizationRoleResource) getNewParams(ctx context.Context, data OrganizationRoleResourceModel) (*openai.AdminOrganizationRoleNewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationRoleNewParams{
+		RoleName:    data.Name.ValueString(),
+		Permissions: mergeDiagnostics(openaiparam.FromSet(ctx, data.Permissions))(&diags),
+		Description: openai.String(data.Description.ValueString()),
+	}, diags
+}
+
+func (r *OrganizationRoleResource) getUpdateParams(ctx context.Context, data OrganizationRoleResourceModel) (*openai.AdminOrganizationRoleUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationRoleUpdateParams{
+		RoleName:    openai.String(data.Name.ValueString()),
+		Permissions: mergeDiagnostics(openaiparam.FromSet(ctx, data.Permissions))(&diags),
+		Description: openai.String(data.Description.ValueString()),
+	}, diags
+}
+
 func (r *OrganizationRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -190,7 +217,7 @@ func (m *OrganizationRoleResourceModel) Fill(ctx context.Context, data openai.Ro
 	m.Id = supertypes.NewStringValue(string(data.ID))
 	m.Name = supertypes.NewStringValue(string(data.Name))
 	m.Description = supertypes.NewStringValue(string(data.Description))
-	m.Permissions = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(data.Permissions))
+	m.Permissions = openaiparam.ToSet(ctx, data.Permissions, openaiparam.String)
 
 	return
 }
@@ -3,24 +3,15 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/openai/openai-go/v3"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-func (r *OrganizationRoleResource) getNewParams(ctx context.Context, data OrganizationRoleResourceModel) (*openai.AdminOrganizationRoleNewParams, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	return &openai.AdminOrganizationRoleNewParams{
-		RoleName:    data.Name.ValueString(),
-		Permissions: mergeDiagnostics(data.Permissions.Get(ctx))(&diags),
-		Description: openai.String(data.Description.ValueString()),
-	}, diags
-}
+var _ resource.ResourceWithModifyPlan = &OrganizationRoleResource{}
 
-func (r *OrganizationRoleResource) getUpdateParams(ctx context.Context, data OrganizationRoleResourceModel) (*openai.AdminOrganizationRoleUpdateParams, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	return &openai.AdminOrganizationRoleUpdateParams{
-		RoleName:    openai.String(data.Name.ValueString()),
-		Permissions: mergeDiagnostics(data.Permissions.Get(ctx))(&diags),
-		Description: openai.String(data.Description.ValueString()),
-	}, diags
+// ModifyPlan fails the plan when the role grants permissions that organization
+// roles do not have, instead of leaving it to the API during apply.
+func (r *OrganizationRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(modifyPlanPermissions(ctx, r.client, req.Plan, roleResourceTypeOrganization, func() (permissionCatalog, error) {
+		return organizationPermissionCatalog(ctx, r.client)
+	})...)
 }
@@ -24,9 +24,3 @@ func (m *ProjectGroupRoleAssignmentResourceModel) Fill(ctx context.Context, data
 		return diags
 	}
 }
-
-func (r *ProjectGroupRoleAssignmentResource) getNewParams(ctx context.Context, data ProjectGroupRoleAssignmentResourceModel) (*openai.AdminOrganizationProjectGroupRoleNewParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationProjectGroupRoleNewParams{
-		RoleID: data.RoleId.ValueString(),
-	}, nil
-}
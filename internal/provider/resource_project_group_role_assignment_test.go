@@ -15,6 +15,8 @@ import (
 )
 
 func TestAccProjectGroupRoleAssignmentResource(t *testing.T) {
+	group := acctest.Group(t)
+
 	rn := "openai_project_group_role_assignment.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 	projectName := sdkacctest.RandomWithPrefix("tf-project")
@@ -24,10 +26,10 @@ func TestAccProjectGroupRoleAssignmentResource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccProjectGroupRoleAssignmentResourceConfig(projectName, acctest.TestGroupId, roleName),
+				Config: testAccProjectGroupRoleAssignmentResourceConfig(projectName, group.ID, roleName),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project_id"), knownvalue.NotNull()),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("group_id"), knownvalue.StringExact(acctest.TestGroupId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("group_id"), knownvalue.StringExact(group.ID)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role_id"), knownvalue.NotNull()),
 				},
 			},
@@ -42,7 +44,7 @@ func TestAccProjectGroupRoleAssignmentResource(t *testing.T) {
 					projectId := rs.Primary.Attributes["project_id"]
 					groupId := rs.Primary.Attributes["group_id"]
 					roleId := rs.Primary.Attributes["role_id"]
-					return tfutils.BuildThreePartId(projectId, groupId, roleId), nil
+					return tfutils.NewCompositeID("project_id", "group_id", "role_id").Build(projectId, groupId, roleId), nil
 				},
 			},
 		},
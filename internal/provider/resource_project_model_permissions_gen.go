@@ -4,7 +4,6 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -15,9 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
-	"github.com/samber/lo"
 )
 
 var _ resource.Resource = &ProjectModelPermissionsResource{}
@@ -80,7 +80,7 @@ func (r *ProjectModelPermissionsResource) Create(ctx context.Context, req resour
 
 	modelInstance, err := r.client.Admin.Organization.Projects.ModelPermissions.Update(ctx, data.ProjectId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -93,6 +93,11 @@ func (r *ProjectModelPermissionsResource) Create(ctx context.Context, req resour
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *ProjectModelPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -103,14 +108,18 @@ func (r *ProjectModelPermissionsResource) Read(ctx context.Context, req resource
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Projects.ModelPermissions.Get(ctx, data.ProjectId.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Projects.ModelPermissions.Get(ctx, data.ProjectId.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -141,7 +150,7 @@ func (r *ProjectModelPermissionsResource) Update(ctx context.Context, req resour
 
 	modelInstance, err := r.client.Admin.Organization.Projects.ModelPermissions.Update(ctx, data.ProjectId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -170,11 +179,23 @@ func (r *ProjectModelPermissionsResource) Delete(ctx context.Context, req resour
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *ProjectModelPermissionsResource) getNewParams(ctx context.Context, data ProjectModelPermissionsResourceModel) (*openai.AdminOrganizationProjectModelPermissionUpdateParams, diag.Diagnostics) {
+	return r.getUpdateParams(ctx, data)
+}
+
+func (r *ProjectModelPermissionsResource) getUpdateParams(ctx context.Context, data ProjectModelPermissionsResourceModel) (*openai.AdminOrganizationProjectModelPermissionUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationProjectModelPermissionUpdateParams{
+		Mode:     mergeDiagnostics(openaiparam.FromEnum(path.Root("mode"), data.Mode, openai.AdminOrganizationProjectModelPermissionUpdateParamsModeAllowList, openai.AdminOrganizationProjectModelPermissionUpdateParamsModeDenyList))(&diags),
+		ModelIDs: mergeDiagnostics(openaiparam.FromSet(ctx, data.ModelIds))(&diags),
+	}, diags
+}
+
 func (r *ProjectModelPermissionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
 }
@@ -187,7 +208,7 @@ type ProjectModelPermissionsResourceModel struct {
 
 func (m *ProjectModelPermissionsResourceModel) Fill(ctx context.Context, data openai.ProjectModelPermissions) (diags diag.Diagnostics) {
 	m.Mode = supertypes.NewStringValue(string(data.Mode))
-	m.ModelIds = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(data.ModelIDs))
+	m.ModelIds = openaiparam.ToSet(ctx, data.ModelIDs, openaiparam.String)
 
 	return
 }
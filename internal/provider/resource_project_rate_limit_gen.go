@@ -3,13 +3,16 @@ package provider
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
@@ -30,7 +33,7 @@ func (r *ProjectRateLimitResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *ProjectRateLimitResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manage rate limits per model for projects. Rate limits may be configured to be equal to or lower than the organization's rate limits.",
+		MarkdownDescription: "Manage rate limits per model for projects. Rate limits may be configured to be equal to or lower than the organization's rate limits. Destroying the resource restores the rate limits from before it was created, if known.",
 		Attributes: map[string]schema.Attribute{
 			"project_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the project.",
@@ -96,6 +99,18 @@ func (r *ProjectRateLimitResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	// The settings read before the resource is created are restored by Delete.
+	original, err := r.readOriginal(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "read", err))
+		return
+	} else if original != nil {
+		resp.Diagnostics.Append(setOriginal(ctx, resp.Private, original)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	body, diags := r.getNewParams(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -104,7 +119,7 @@ func (r *ProjectRateLimitResource) Create(ctx context.Context, req resource.Crea
 
 	modelInstance, err := r.client.Admin.Organization.Projects.RateLimits.UpdateRateLimit(ctx, data.ProjectId.ValueString(), data.RateLimitId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -117,6 +132,11 @@ func (r *ProjectRateLimitResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *ProjectRateLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -131,19 +151,24 @@ func (r *ProjectRateLimitResource) Read(ctx context.Context, req resource.ReadRe
 		Limit: openai.Int(100),
 	}
 
-	var modelInstance *openai.ProjectRateLimit
-
-	iter := r.client.Admin.Organization.Projects.RateLimits.ListRateLimitsAutoPaging(ctx, data.ProjectId.ValueString(), params)
-	for iter.Next() {
-		currentModelInstance := iter.Current()
-		if r.resourceMatch(data, currentModelInstance) {
-			modelInstance = new(currentModelInstance)
-			break
+	findModelInstance := func() (*openai.ProjectRateLimit, error) {
+		iter := r.client.Admin.Organization.Projects.RateLimits.ListRateLimitsAutoPaging(ctx, data.ProjectId.ValueString(), params)
+		for iter.Next() {
+			currentModelInstance := iter.Current()
+			if r.resourceMatch(data, currentModelInstance) {
+				return new(currentModelInstance), nil
+			}
 		}
+		return nil, iter.Err()
 	}
 
-	if err := iter.Err(); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+	// The object may not be listed yet if it was just created.
+	modelInstance, err := findModelInstance()
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, err == nil && modelInstance == nil); {
+		modelInstance, err = findModelInstance()
+	}
+	if err != nil {
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.State.RemoveResource(ctx)
@@ -174,7 +199,7 @@ func (r *ProjectRateLimitResource) Update(ctx context.Context, req resource.Upda
 
 	modelInstance, err := r.client.Admin.Organization.Projects.RateLimits.UpdateRateLimit(ctx, data.ProjectId.ValueString(), data.RateLimitId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -190,7 +215,72 @@ func (r *ProjectRateLimitResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *ProjectRateLimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddWarning("Not Supported", "Delete is not supported for this resource. Please manually delete the resource.")
+	var data ProjectRateLimitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	original, diags := getOriginal[openai.ProjectRateLimit](ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.getResetParams(ctx, data, original)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if body == nil {
+		if r.refuseReadOnly(&resp.Diagnostics, "remove the resource from the Terraform state without resetting its settings") {
+			return
+		}
+
+		resp.Diagnostics.AddWarning("Settings Not Reset", "The settings from before this resource was created are unknown, e.g. because it was imported, and there is no documented default to reset them to. The resource was removed from the Terraform state and the settings were left as they are.")
+		return
+	}
+
+	_, err := r.client.Admin.Organization.Projects.RateLimits.UpdateRateLimit(ctx, data.ProjectId.ValueString(), data.RateLimitId.ValueString(), *body)
+	if err != nil {
+		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
+		return
+	}
+}
+
+// readOriginal reads the settings that Delete restores.
+func (r *ProjectRateLimitResource) readOriginal(ctx context.Context, data ProjectRateLimitResourceModel) (*openai.ProjectRateLimit, error) {
+	params := openai.AdminOrganizationProjectRateLimitListRateLimitsParams{
+		Limit: openai.Int(100),
+	}
+
+	iter := r.client.Admin.Organization.Projects.RateLimits.ListRateLimitsAutoPaging(ctx, data.ProjectId.ValueString(), params)
+	for iter.Next() {
+		if current := iter.Current(); r.resourceMatch(data, current) {
+			return new(current), nil
+		}
+	}
+	return nil, iter.Err()
+}
+
+func (r *ProjectRateLimitResource) getNewParams(ctx context.Context, data ProjectRateLimitResourceModel) (*openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams, diag.Diagnostics) {
+	return r.getUpdateParams(ctx, data)
+}
+
+func (r *ProjectRateLimitResource) getUpdateParams(ctx context.Context, data ProjectRateLimitResourceModel) (*openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams{
+		Batch1DayMaxInputTokens:     openaiparam.FromInt64(data.Batch1DayMaxInputTokens),
+		MaxAudioMegabytesPer1Minute: openaiparam.FromInt64(data.MaxAudioMegabytesPer1Minute),
+		MaxImagesPer1Minute:         openaiparam.FromInt64(data.MaxImagesPer1Minute),
+		MaxRequestsPer1Day:          openaiparam.FromInt64(data.MaxRequestsPer1Day),
+		MaxRequestsPer1Minute:       openaiparam.FromInt64(data.MaxRequestsPer1Minute),
+		MaxTokensPer1Minute:         openaiparam.FromInt64(data.MaxTokensPer1Minute),
+	}, diags
 }
 
 type ProjectRateLimitResourceModel struct {
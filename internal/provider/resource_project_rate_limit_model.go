@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 )
 
@@ -12,17 +11,29 @@ func (r *ProjectRateLimitResource) resourceMatch(data ProjectRateLimitResourceMo
 	return data.RateLimitId.ValueString() == rateLimit.ID
 }
 
-func (r *ProjectRateLimitResource) getNewParams(ctx context.Context, data ProjectRateLimitResourceModel) (*openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams, diag.Diagnostics) {
-	return r.getUpdateParams(ctx, data)
-}
+// getResetParams restores the original rate limits of the model. The defaults
+// are the organization's rate limits, which the API does not expose, so the
+// rate limits are left as they are when the original is unknown.
+func (r *ProjectRateLimitResource) getResetParams(ctx context.Context, data ProjectRateLimitResourceModel, original *openai.ProjectRateLimit) (*openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams, diag.Diagnostics) {
+	if original == nil {
+		return nil, nil
+	}
 
-func (r *ProjectRateLimitResource) getUpdateParams(ctx context.Context, data ProjectRateLimitResourceModel) (*openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams{
-		Batch1DayMaxInputTokens:     openaiparam.FromInt64(data.Batch1DayMaxInputTokens),
-		MaxAudioMegabytesPer1Minute: openaiparam.FromInt64(data.MaxAudioMegabytesPer1Minute),
-		MaxImagesPer1Minute:         openaiparam.FromInt64(data.MaxImagesPer1Minute),
-		MaxRequestsPer1Day:          openaiparam.FromInt64(data.MaxRequestsPer1Day),
-		MaxRequestsPer1Minute:       openaiparam.FromInt64(data.MaxRequestsPer1Minute),
-		MaxTokensPer1Minute:         openaiparam.FromInt64(data.MaxTokensPer1Minute),
-	}, nil
+	params := &openai.AdminOrganizationProjectRateLimitUpdateRateLimitParams{
+		MaxRequestsPer1Minute: openai.Int(original.MaxRequestsPer1Minute),
+		MaxTokensPer1Minute:   openai.Int(original.MaxTokensPer1Minute),
+	}
+	if original.JSON.Batch1DayMaxInputTokens.Valid() {
+		params.Batch1DayMaxInputTokens = openai.Int(original.Batch1DayMaxInputTokens)
+	}
+	if original.JSON.MaxAudioMegabytesPer1Minute.Valid() {
+		params.MaxAudioMegabytesPer1Minute = openai.Int(original.MaxAudioMegabytesPer1Minute)
+	}
+	if original.JSON.MaxImagesPer1Minute.Valid() {
+		params.MaxImagesPer1Minute = openai.Int(original.MaxImagesPer1Minute)
+	}
+	if original.JSON.MaxRequestsPer1Day.Valid() {
+		params.MaxRequestsPer1Day = openai.Int(original.MaxRequestsPer1Day)
+	}
+	return params, nil
 }
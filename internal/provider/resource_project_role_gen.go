@@ -13,10 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/jianyuan/terraform-provider-openai/internal/tfutils"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
-	"github.com/samber/lo"
 )
 
 var _ resource.Resource = &ProjectRoleResource{}
@@ -62,7 +63,7 @@ func (r *ProjectRoleResource) Schema(ctx context.Context, req resource.SchemaReq
 				CustomType:          supertypes.StringType{},
 			},
 			"permissions": schema.SetAttribute{
-				MarkdownDescription: "Permissions to grant to the role.",
+				MarkdownDescription: "Permissions to grant to the role, as listed by the `openai_permissions` data source. Permissions that no predefined role of the project grants fail the plan, if the project already exists.",
 				Required:            true,
 				CustomType:          supertypes.NewSetTypeOf[string](ctx),
 			},
@@ -86,7 +87,7 @@ func (r *ProjectRoleResource) Create(ctx context.Context, req resource.CreateReq
 
 	modelInstance, err := r.client.Admin.Organization.Projects.Roles.New(ctx, data.ProjectId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -99,6 +100,11 @@ func (r *ProjectRoleResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *ProjectRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -109,14 +115,18 @@ func (r *ProjectRoleResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Projects.Roles.Get(ctx, data.ProjectId.ValueString(), data.Id.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Projects.Roles.Get(ctx, data.ProjectId.ValueString(), data.Id.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -147,7 +157,7 @@ func (r *ProjectRoleResource) Update(ctx context.Context, req resource.UpdateReq
 
 	modelInstance, err := r.client.Admin.Organization.Projects.Roles.Update(ctx, data.ProjectId.ValueString(), data.Id.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -176,24 +186,40 @@ func (r *ProjectRoleResource) Delete(ctx context.Context, req resource.DeleteReq
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *ProjectRoleResource) getNewParams(ctx context.Context, data ProjectRoleResourceModel) (*openai.AdminOrganizationProjectRoleNewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationProjectRoleNewParams{
+		RoleName:    data.Name.ValueString(),
+		Permissions: mergeDiagnostics(openaiparam.FromSet(ctx, data.Permissions))(&diags),
+		Description: openai.String(data.Description.ValueString()),
+	}, diags
+}
+
+func (r *ProjectRoleResource) getUpdateParams(ctx context.Context, data ProjectRoleResourceModel) (*openai.AdminOrganizationProjectRoleUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationProjectRoleUpdateParams{
+		RoleName:    openai.String(data.Name.ValueString()),
+		Permissions: mergeDiagnostics(openaiparam.FromSet(ctx, data.Permissions))(&diags),
+		Description: openai.String(data.Description.ValueString()),
+	}, diags
+}
+
 func (r *ProjectRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	first, second, err := tfutils.SplitTwoPartId(req.ID, "project_id", "id")
+	id := tfutils.NewCompositeID("project_id", "id")
+	parts, err := id.Parse(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("project_id"), first,
-	)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("id"), second,
-	)...)
+	for i, name := range id.Names() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
+	}
 }
 
 type ProjectRoleResourceModel struct {
@@ -208,7 +234,7 @@ func (m *ProjectRoleResourceModel) Fill(ctx context.Context, data openai.Role) (
 	m.Id = supertypes.NewStringValue(string(data.ID))
 	m.Name = supertypes.NewStringValue(string(data.Name))
 	m.Description = supertypes.NewStringValue(string(data.Description))
-	m.Permissions = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(data.Permissions))
+	m.Permissions = openaiparam.ToSet(ctx, data.Permissions, openaiparam.String)
 
 	return
 }
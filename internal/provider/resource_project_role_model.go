@@ -3,24 +3,28 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/openai/openai-go/v3"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
-func (r *ProjectRoleResource) getNewParams(ctx context.Context, data ProjectRoleResourceModel) (*openai.AdminOrganizationProjectRoleNewParams, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	return &openai.AdminOrganizationProjectRoleNewParams{
-		RoleName:    data.Name.ValueString(),
-		Permissions: mergeDiagnostics(data.Permissions.Get(ctx))(&diags),
-		Description: openai.String(data.Description.ValueString()),
-	}, diags
-}
+var _ resource.ResourceWithModifyPlan = &ProjectRoleResource{}
+
+// ModifyPlan fails the plan when the role grants permissions that the roles of
+// its project do not have. The permissions of a project that does not exist
+// yet are only checked by the API.
+func (r *ProjectRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var projectId supertypes.StringValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	if resp.Diagnostics.HasError() || !projectId.IsKnown() {
+		return
+	}
 
-func (r *ProjectRoleResource) getUpdateParams(ctx context.Context, data ProjectRoleResourceModel) (*openai.AdminOrganizationProjectRoleUpdateParams, diag.Diagnostics) {
-	var diags diag.Diagnostics
-	return &openai.AdminOrganizationProjectRoleUpdateParams{
-		RoleName:    openai.String(data.Name.ValueString()),
-		Permissions: mergeDiagnostics(data.Permissions.Get(ctx))(&diags),
-		Description: openai.String(data.Description.ValueString()),
-	}, diags
+	resp.Diagnostics.Append(modifyPlanPermissions(ctx, r.client, req.Plan, roleResourceTypeProject, func() (permissionCatalog, error) {
+		return projectPermissionCatalog(ctx, r.client, projectId.ValueString())
+	})...)
 }
@@ -1,10 +1,7 @@
 package provider_test
 
 import (
-	"context"
 	"fmt"
-	"log"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
@@ -14,54 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/jianyuan/terraform-provider-openai/internal/acctest"
-	"github.com/jianyuan/terraform-provider-openai/internal/provider"
-	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
-	"github.com/openai/openai-go/v3"
 )
 
-func init() {
-	sweep.Register("openai_project_service_account", func(ctx context.Context, client *openai.Client) ([]sweep.Sweepable, error) {
-		var sweepables []sweep.Sweepable
-
-		params := openai.AdminOrganizationProjectListParams{
-			Limit: openai.Int(100),
-		}
-		var projectIds []string
-		iter := acctest.SharedClient.Admin.Organization.Projects.ListAutoPaging(ctx, params)
-		for iter.Next() {
-			item := iter.Current()
-			projectIds = append(projectIds, item.ID)
-		}
-
-		for _, projectId := range projectIds {
-			log.Printf("[INFO] Listing project service accounts for project %s", projectId)
-
-			params := openai.AdminOrganizationProjectServiceAccountListParams{
-				Limit: openai.Int(100),
-			}
-
-			var ids []string
-
-			iter := acctest.SharedClient.Admin.Organization.Projects.ServiceAccounts.ListAutoPaging(ctx, projectId, params)
-			for iter.Next() {
-				item := iter.Current()
-				if strings.HasPrefix(item.Name, "tf-") || strings.HasPrefix(item.Name, "test-") {
-					ids = append(ids, item.ID)
-				}
-			}
-
-			for _, id := range ids {
-				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectServiceAccountResource, acctest.SharedClient, map[string]any{
-					"project_id": projectId,
-					"id":         id,
-				}))
-			}
-		}
-
-		return sweepables, nil
-	})
-}
-
 func TestAccProjectServiceAccountResource(t *testing.T) {
 	rn := "openai_project_service_account.test"
 	projectName := sdkacctest.RandomWithPrefix("tf-project")
@@ -15,10 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/jianyuan/terraform-provider-openai/internal/tfutils"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
-	"github.com/samber/lo"
 )
 
 var _ resource.Resource = &ProjectSpendAlertResource{}
@@ -60,11 +61,17 @@ func (r *ProjectSpendAlertResource) Schema(ctx context.Context, req resource.Sch
 				MarkdownDescription: "The currency for the threshold amount (e.g. `USD`).",
 				Required:            true,
 				CustomType:          supertypes.StringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOf("USD"),
+				},
 			},
 			"interval": schema.StringAttribute{
 				MarkdownDescription: "The interval for the spend alert (e.g. `month`).",
 				Required:            true,
 				CustomType:          supertypes.StringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOf("month"),
+				},
 			},
 			"notification_channel": schema.SingleNestedAttribute{
 				MarkdownDescription: "Email notification settings for a spend alert.",
@@ -116,7 +123,7 @@ func (r *ProjectSpendAlertResource) Create(ctx context.Context, req resource.Cre
 
 	modelInstance, err := r.client.Admin.Organization.Projects.SpendAlerts.New(ctx, data.ProjectId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -129,6 +136,11 @@ func (r *ProjectSpendAlertResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *ProjectSpendAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -139,14 +151,18 @@ func (r *ProjectSpendAlertResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Projects.SpendAlerts.Get(ctx, data.ProjectId.ValueString(), data.Id.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Projects.SpendAlerts.Get(ctx, data.ProjectId.ValueString(), data.Id.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -177,7 +193,7 @@ func (r *ProjectSpendAlertResource) Update(ctx context.Context, req resource.Upd
 
 	modelInstance, err := r.client.Admin.Organization.Projects.SpendAlerts.Update(ctx, data.ProjectId.ValueString(), data.Id.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -206,24 +222,54 @@ func (r *ProjectSpendAlertResource) Delete(ctx context.Context, req resource.Del
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *ProjectSpendAlertResource) getNewParams(ctx context.Context, data ProjectSpendAlertResourceModel) (*openai.AdminOrganizationProjectSpendAlertNewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationProjectSpendAlertNewParams{
+		Currency:        mergeDiagnostics(openaiparam.FromEnum(path.Root("currency"), data.Currency, openai.AdminOrganizationProjectSpendAlertNewParamsCurrencyUsd))(&diags),
+		Interval:        mergeDiagnostics(openaiparam.FromEnum(path.Root("interval"), data.Interval, openai.AdminOrganizationProjectSpendAlertNewParamsIntervalMonth))(&diags),
+		ThresholdAmount: data.ThresholdAmount.ValueInt64(),
+		NotificationChannel: mergeDiagnostics(openaiparam.FromSingleNested(ctx, data.NotificationChannel, func(ctx context.Context, data *ProjectSpendAlertResourceModelNotificationChannel) (openai.AdminOrganizationProjectSpendAlertNewParamsNotificationChannel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			return openai.AdminOrganizationProjectSpendAlertNewParamsNotificationChannel{
+				Recipients:    mergeDiagnostics(openaiparam.FromSet(ctx, data.Recipients))(&diags),
+				SubjectPrefix: openaiparam.FromString(data.SubjectPrefix),
+			}, diags
+		}))(&diags),
+	}, diags
+}
+
+func (r *ProjectSpendAlertResource) getUpdateParams(ctx context.Context, data ProjectSpendAlertResourceModel) (*openai.AdminOrganizationProjectSpendAlertUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationProjectSpendAlertUpdateParams{
+		Currency:        mergeDiagnostics(openaiparam.FromEnum(path.Root("currency"), data.Currency, openai.AdminOrganizationProjectSpendAlertUpdateParamsCurrencyUsd))(&diags),
+		Interval:        mergeDiagnostics(openaiparam.FromEnum(path.Root("interval"), data.Interval, openai.AdminOrganizationProjectSpendAlertUpdateParamsIntervalMonth))(&diags),
+		ThresholdAmount: data.ThresholdAmount.ValueInt64(),
+		NotificationChannel: mergeDiagnostics(openaiparam.FromSingleNested(ctx, data.NotificationChannel, func(ctx context.Context, data *ProjectSpendAlertResourceModelNotificationChannel) (openai.AdminOrganizationProjectSpendAlertUpdateParamsNotificationChannel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			return openai.AdminOrganizationProjectSpendAlertUpdateParamsNotificationChannel{
+				Recipients:    mergeDiagnostics(openaiparam.FromSet(ctx, data.Recipients))(&diags),
+				SubjectPrefix: openaiparam.FromString(data.SubjectPrefix),
+			}, diags
+		}))(&diags),
+	}, diags
+}
+
 func (r *ProjectSpendAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	first, second, err := tfutils.SplitTwoPartId(req.ID, "project_id", "id")
+	id := tfutils.NewCompositeID("project_id", "id")
+	parts, err := id.Parse(req.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("project_id"), first,
-	)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("id"), second,
-	)...)
+	for i, name := range id.Names() {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
+	}
 }
 
 type ProjectSpendAlertResourceModel struct {
@@ -257,7 +303,7 @@ type ProjectSpendAlertResourceModelNotificationChannel struct {
 
 func (m *ProjectSpendAlertResourceModelNotificationChannel) Fill(ctx context.Context, data openai.ProjectSpendAlertNotificationChannel) (diags diag.Diagnostics) {
 	m.Type = supertypes.NewStringValue(string(data.Type))
-	m.Recipients = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(data.Recipients))
+	m.Recipients = openaiparam.ToSet(ctx, data.Recipients, openaiparam.String)
 	m.SubjectPrefix = (func() supertypes.StringValue {
 		if data.JSON.SubjectPrefix.Valid() {
 			return supertypes.NewStringValue(string(data.SubjectPrefix))
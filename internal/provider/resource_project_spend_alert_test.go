@@ -24,7 +24,7 @@ func TestAccProjectSpendAlertResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProjectSpendAlertResourceConfig(projectName, 10, `
-					recipients = ["a@example.com"]
+					recipients = ["tf-a@example.com"]
 				`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project_id"), knownvalue.NotNull()),
@@ -32,13 +32,13 @@ func TestAccProjectSpendAlertResource(t *testing.T) {
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("interval"), knownvalue.StringExact("month")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("threshold_amount"), knownvalue.Int32Exact(10)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("type"), knownvalue.StringExact("email")),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("a@example.com")})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("tf-a@example.com")})),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("subject_prefix"), knownvalue.Null()),
 				},
 			},
 			{
 				Config: testAccProjectSpendAlertResourceConfig(projectName, 10, `
-					recipients = ["a@example.com", "b@example.com"]
+					recipients = ["tf-a@example.com", "tf-b@example.com"]
 					subject_prefix = "OpenAI Terraform"
 				`),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -47,7 +47,7 @@ func TestAccProjectSpendAlertResource(t *testing.T) {
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("interval"), knownvalue.StringExact("month")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("threshold_amount"), knownvalue.Int32Exact(10)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("type"), knownvalue.StringExact("email")),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("a@example.com"), knownvalue.StringExact("b@example.com")})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("tf-a@example.com"), knownvalue.StringExact("tf-b@example.com")})),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("subject_prefix"), knownvalue.StringExact("OpenAI Terraform")),
 				},
 			},
@@ -61,7 +61,7 @@ func TestAccProjectSpendAlertResource(t *testing.T) {
 					}
 					projectId := rs.Primary.Attributes["project_id"]
 					alertId := rs.Primary.Attributes["id"]
-					return tfutils.BuildTwoPartId(projectId, alertId), nil
+					return tfutils.NewCompositeID("project_id", "id").Build(projectId, alertId), nil
 				},
 				ImportStateVerify: true,
 			},
@@ -4,7 +4,6 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -16,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
@@ -103,7 +104,7 @@ func (r *ProjectSpendLimitResource) Create(ctx context.Context, req resource.Cre
 
 	modelInstance, err := r.client.Admin.Organization.Projects.SpendLimit.Update(ctx, data.ProjectId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -116,6 +117,11 @@ func (r *ProjectSpendLimitResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *ProjectSpendLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -126,14 +132,18 @@ func (r *ProjectSpendLimitResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Projects.SpendLimit.Get(ctx, data.ProjectId.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Projects.SpendLimit.Get(ctx, data.ProjectId.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -164,7 +174,7 @@ func (r *ProjectSpendLimitResource) Update(ctx context.Context, req resource.Upd
 
 	modelInstance, err := r.client.Admin.Organization.Projects.SpendLimit.Update(ctx, data.ProjectId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -193,11 +203,24 @@ func (r *ProjectSpendLimitResource) Delete(ctx context.Context, req resource.Del
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *ProjectSpendLimitResource) getNewParams(ctx context.Context, data ProjectSpendLimitResourceModel) (*openai.AdminOrganizationProjectSpendLimitUpdateParams, diag.Diagnostics) {
+	return r.getUpdateParams(ctx, data)
+}
+
+func (r *ProjectSpendLimitResource) getUpdateParams(ctx context.Context, data ProjectSpendLimitResourceModel) (*openai.AdminOrganizationProjectSpendLimitUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationProjectSpendLimitUpdateParams{
+		Currency:        mergeDiagnostics(openaiparam.FromEnum(path.Root("currency"), data.Currency, openai.AdminOrganizationProjectSpendLimitUpdateParamsCurrencyUsd))(&diags),
+		Interval:        mergeDiagnostics(openaiparam.FromEnum(path.Root("interval"), data.Interval, openai.AdminOrganizationProjectSpendLimitUpdateParamsIntervalMonth))(&diags),
+		ThresholdAmount: data.ThresholdAmount.ValueInt64(),
+	}, diags
+}
+
 func (r *ProjectSpendLimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("project_id"), req, resp)
 }
@@ -1,51 +1,25 @@
 package provider_test
 
 import (
-	"context"
 	"fmt"
-	"strings"
 	"testing"
 
-	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/jianyuan/terraform-provider-openai/internal/acctest"
-	"github.com/jianyuan/terraform-provider-openai/internal/provider"
-	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
-	"github.com/openai/openai-go/v3"
 )
 
-func init() {
-	sweep.Register("openai_project", func(ctx context.Context, client *openai.Client) ([]sweep.Sweepable, error) {
-		params := openai.AdminOrganizationProjectListParams{
-			Limit: openai.Int(100),
-		}
-
-		var sweepables []sweep.Sweepable
-
-		iter := acctest.SharedClient.Admin.Organization.Projects.ListAutoPaging(ctx, params)
-		for iter.Next() {
-			item := iter.Current()
-			if strings.HasPrefix(item.Name, "tf-") {
-				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectResource, acctest.SharedClient, map[string]any{
-					"id": item.ID,
-				}))
-			}
-		}
-
-		return sweepables, nil
-	})
-}
-
 func TestAccProjectResource(t *testing.T) {
+	cassette := acctest.NewCassette(t)
+
 	rn := "openai_project.test"
-	projectName := sdkacctest.RandomWithPrefix("tf-project")
+	projectName := cassette.RandomWithPrefix("tf-project")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: cassette.ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProjectResourceConfig(projectName),
@@ -81,12 +55,14 @@ func TestAccProjectResource(t *testing.T) {
 }
 
 func TestAccProjectResource_WithGeography(t *testing.T) {
+	cassette := acctest.NewCassette(t)
+
 	rn := "openai_project.test"
-	projectName := sdkacctest.RandomWithPrefix("tf-project")
+	projectName := cassette.RandomWithPrefix("tf-project")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(t) },
-		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: cassette.ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProjectResourceConfigWithGeography(projectName, "US"),
@@ -3,19 +3,29 @@ package provider
 import (
 	"context"
 
-	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/openai/openai-go/v3"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
-func (r *ProjectUserResource) getNewParams(ctx context.Context, data ProjectUserResourceModel) (*openai.AdminOrganizationProjectUserNewParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationProjectUserNewParams{
-		Role:   data.Role.ValueString(),
-		UserID: openai.String(data.UserId.ValueString()),
-	}, nil
-}
+var _ resource.ResourceWithModifyPlan = &ProjectUserResource{}
+
+// ModifyPlan fails the plan when the user is given a role that is not one of
+// the predefined roles of the project. The roles of a project that does not
+// exist yet are only checked by the API.
+func (r *ProjectUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var projectId supertypes.StringValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+	if resp.Diagnostics.HasError() || !projectId.IsKnown() {
+		return
+	}
 
-func (r *ProjectUserResource) getUpdateParams(ctx context.Context, data ProjectUserResourceModel) (*openai.AdminOrganizationProjectUserUpdateParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationProjectUserUpdateParams{
-		Role: openai.String(data.Role.ValueString()),
-	}, nil
+	resp.Diagnostics.Append(modifyPlanRole(ctx, r.client, req.Plan, roleResourceTypeProject, func() ([]openai.Role, error) {
+		return projectRoles(ctx, r.client, projectId.ValueString())
+	})...)
 }
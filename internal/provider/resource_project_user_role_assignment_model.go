@@ -24,9 +24,3 @@ func (m *ProjectUserRoleAssignmentResourceModel) Fill(ctx context.Context, data
 		return diags
 	}
 }
-
-func (r *ProjectUserRoleAssignmentResource) getNewParams(ctx context.Context, data ProjectUserRoleAssignmentResourceModel) (*openai.AdminOrganizationProjectUserRoleNewParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationProjectUserRoleNewParams{
-		RoleID: data.RoleId.ValueString(),
-	}, nil
-}
@@ -15,6 +15,8 @@ import (
 )
 
 func TestAccProjectUserRoleAssignmentResource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "openai_project_user_role_assignment.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 	projectName := sdkacctest.RandomWithPrefix("tf-project")
@@ -24,10 +26,10 @@ func TestAccProjectUserRoleAssignmentResource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccProjectUserRoleAssignmentResourceConfig(projectName, roleName, acctest.TestUserId),
+				Config: testAccProjectUserRoleAssignmentResourceConfig(projectName, roleName, user.ID),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("project_id"), knownvalue.NotNull()),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(acctest.TestUserId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(user.ID)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role_id"), knownvalue.NotNull()),
 				},
 			},
@@ -42,7 +44,7 @@ func TestAccProjectUserRoleAssignmentResource(t *testing.T) {
 					projectId := rs.Primary.Attributes["project_id"]
 					userId := rs.Primary.Attributes["user_id"]
 					roleId := rs.Primary.Attributes["role_id"]
-					return tfutils.BuildThreePartId(projectId, userId, roleId), nil
+					return tfutils.NewCompositeID("project_id", "user_id", "role_id").Build(projectId, userId, roleId), nil
 				},
 			},
 		},
@@ -16,6 +16,8 @@ import (
 )
 
 func TestAccProjectUserResource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "openai_project_user.test"
 	projectName := sdkacctest.RandomWithPrefix("tf-project")
 
@@ -24,10 +26,10 @@ func TestAccProjectUserResource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccProjectUserResourceConfig(projectName, acctest.TestUserId, "owner"),
+				Config: testAccProjectUserResourceConfig(projectName, user.ID, "owner"),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.CompareValuePairs("openai_project.test", tfjsonpath.New("id"), rn, tfjsonpath.New("project_id"), compare.ValuesSame()),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(acctest.TestUserId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(user.ID)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("owner")),
 				},
 			},
@@ -41,14 +43,14 @@ func TestAccProjectUserResource(t *testing.T) {
 					}
 					projectId := rs.Primary.Attributes["project_id"]
 					userId := rs.Primary.Attributes["user_id"]
-					return tfutils.BuildTwoPartId(projectId, userId), nil
+					return tfutils.NewCompositeID("project_id", "user_id").Build(projectId, userId), nil
 				},
 			},
 			{
-				Config: testAccProjectUserResourceConfig(projectName, acctest.TestUserId, "member"),
+				Config: testAccProjectUserResourceConfig(projectName, user.ID, "member"),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.CompareValuePairs("openai_project.test", tfjsonpath.New("id"), rn, tfjsonpath.New("project_id"), compare.ValuesSame()),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(acctest.TestUserId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(user.ID)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("member")),
 				},
 			},
@@ -62,7 +64,7 @@ func TestAccProjectUserResource(t *testing.T) {
 					}
 					projectId := rs.Primary.Attributes["project_id"]
 					userId := rs.Primary.Attributes["user_id"]
-					return tfutils.BuildTwoPartId(projectId, userId), nil
+					return tfutils.NewCompositeID("project_id", "user_id").Build(projectId, userId), nil
 				},
 			},
 		},
@@ -4,7 +4,6 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -15,9 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
-	"github.com/samber/lo"
 )
 
 var _ resource.Resource = &SpendAlertResource{}
@@ -51,11 +51,17 @@ func (r *SpendAlertResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "The currency for the threshold amount (e.g. `USD`).",
 				Required:            true,
 				CustomType:          supertypes.StringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOf("USD"),
+				},
 			},
 			"interval": schema.StringAttribute{
 				MarkdownDescription: "The interval for the spend alert (e.g. `month`).",
 				Required:            true,
 				CustomType:          supertypes.StringType{},
+				Validators: []validator.String{
+					stringvalidator.OneOf("month"),
+				},
 			},
 			"notification_channel": schema.SingleNestedAttribute{
 				MarkdownDescription: "Email notification settings for a spend alert.",
@@ -107,7 +113,7 @@ func (r *SpendAlertResource) Create(ctx context.Context, req resource.CreateRequ
 
 	modelInstance, err := r.client.Admin.Organization.SpendAlerts.New(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -120,6 +126,11 @@ func (r *SpendAlertResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *SpendAlertResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -130,14 +141,18 @@ func (r *SpendAlertResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.SpendAlerts.Get(ctx, data.Id.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.SpendAlerts.Get(ctx, data.Id.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -168,7 +183,7 @@ func (r *SpendAlertResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	modelInstance, err := r.client.Admin.Organization.SpendAlerts.Update(ctx, data.Id.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -197,11 +212,43 @@ func (r *SpendAlertResource) Delete(ctx context.Context, req resource.DeleteRequ
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *SpendAlertResource) getNewParams(ctx context.Context, data SpendAlertResourceModel) (*openai.AdminOrganizationSpendAlertNewParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationSpendAlertNewParams{
+		Currency:        mergeDiagnostics(openaiparam.FromEnum(path.Root("currency"), data.Currency, openai.AdminOrganizationSpendAlertNewParamsCurrencyUsd))(&diags),
+		Interval:        mergeDiagnostics(openaiparam.FromEnum(path.Root("interval"), data.Interval, openai.AdminOrganizationSpendAlertNewParamsIntervalMonth))(&diags),
+		ThresholdAmount: data.ThresholdAmount.ValueInt64(),
+		NotificationChannel: mergeDiagnostics(openaiparam.FromSingleNested(ctx, data.NotificationChannel, func(ctx context.Context, data *SpendAlertResourceModelNotificationChannel) (openai.AdminOrganizationSpendAlertNewParamsNotificationChannel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			return openai.AdminOrganizationSpendAlertNewParamsNotificationChannel{
+				Recipients:    mergeDiagnostics(openaiparam.FromSet(ctx, data.Recipients))(&diags),
+				SubjectPrefix: openaiparam.FromString(data.SubjectPrefix),
+			}, diags
+		}))(&diags),
+	}, diags
+}
+
+func (r *SpendAlertResource) getUpdateParams(ctx context.Context, data SpendAlertResourceModel) (*openai.AdminOrganizationSpendAlertUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationSpendAlertUpdateParams{
+		Currency:        mergeDiagnostics(openaiparam.FromEnum(path.Root("currency"), data.Currency, openai.AdminOrganizationSpendAlertUpdateParamsCurrencyUsd))(&diags),
+		Interval:        mergeDiagnostics(openaiparam.FromEnum(path.Root("interval"), data.Interval, openai.AdminOrganizationSpendAlertUpdateParamsIntervalMonth))(&diags),
+		ThresholdAmount: data.ThresholdAmount.ValueInt64(),
+		NotificationChannel: mergeDiagnostics(openaiparam.FromSingleNested(ctx, data.NotificationChannel, func(ctx context.Context, data *SpendAlertResourceModelNotificationChannel) (openai.AdminOrganizationSpendAlertUpdateParamsNotificationChannel, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			return openai.AdminOrganizationSpendAlertUpdateParamsNotificationChannel{
+				Recipients:    mergeDiagnostics(openaiparam.FromSet(ctx, data.Recipients))(&diags),
+				SubjectPrefix: openaiparam.FromString(data.SubjectPrefix),
+			}, diags
+		}))(&diags),
+	}, diags
+}
+
 func (r *SpendAlertResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -236,7 +283,7 @@ type SpendAlertResourceModelNotificationChannel struct {
 
 func (m *SpendAlertResourceModelNotificationChannel) Fill(ctx context.Context, data openai.OrganizationSpendAlertNotificationChannel) (diags diag.Diagnostics) {
 	m.Type = supertypes.NewStringValue(string(data.Type))
-	m.Recipients = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(data.Recipients))
+	m.Recipients = openaiparam.ToSet(ctx, data.Recipients, openaiparam.String)
 	m.SubjectPrefix = (func() supertypes.StringValue {
 		if data.JSON.SubjectPrefix.Valid() {
 			return supertypes.NewStringValue(string(data.SubjectPrefix))
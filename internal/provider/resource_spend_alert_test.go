@@ -20,20 +20,20 @@ func TestAccSpendAlertResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccSpendAlertResourceConfig(10, `
-					recipients = ["a@example.com"]
+					recipients = ["tf-a@example.com"]
 				`),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("currency"), knownvalue.StringExact("USD")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("interval"), knownvalue.StringExact("month")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("threshold_amount"), knownvalue.Int32Exact(10)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("type"), knownvalue.StringExact("email")),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("a@example.com")})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("tf-a@example.com")})),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("subject_prefix"), knownvalue.Null()),
 				},
 			},
 			{
 				Config: testAccSpendAlertResourceConfig(10, `
-					recipients = ["a@example.com", "b@example.com"]
+					recipients = ["tf-a@example.com", "tf-b@example.com"]
 					subject_prefix = "OpenAI Terraform"
 				`),
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -41,7 +41,7 @@ func TestAccSpendAlertResource(t *testing.T) {
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("interval"), knownvalue.StringExact("month")),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("threshold_amount"), knownvalue.Int32Exact(10)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("type"), knownvalue.StringExact("email")),
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("a@example.com"), knownvalue.StringExact("b@example.com")})),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("recipients"), knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("tf-a@example.com"), knownvalue.StringExact("tf-b@example.com")})),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("notification_channel").AtMapKey("subject_prefix"), knownvalue.StringExact("OpenAI Terraform")),
 				},
 			},
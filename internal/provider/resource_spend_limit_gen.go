@@ -4,15 +4,17 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
+	"github.com/jianyuan/terraform-provider-openai/internal/openaiparam"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
@@ -91,7 +93,7 @@ func (r *SpendLimitResource) Create(ctx context.Context, req resource.CreateRequ
 
 	modelInstance, err := r.client.Admin.Organization.SpendLimit.Update(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -104,6 +106,11 @@ func (r *SpendLimitResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *SpendLimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -114,14 +121,18 @@ func (r *SpendLimitResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.SpendLimit.Get(ctx)
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.SpendLimit.Get(ctx)
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -152,7 +163,7 @@ func (r *SpendLimitResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	modelInstance, err := r.client.Admin.Organization.SpendLimit.Update(ctx, *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -181,11 +192,24 @@ func (r *SpendLimitResource) Delete(ctx context.Context, req resource.DeleteRequ
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
 		return
 	}
 }
 
+func (r *SpendLimitResource) getNewParams(ctx context.Context, data SpendLimitResourceModel) (*openai.AdminOrganizationSpendLimitUpdateParams, diag.Diagnostics) {
+	return r.getUpdateParams(ctx, data)
+}
+
+func (r *SpendLimitResource) getUpdateParams(ctx context.Context, data SpendLimitResourceModel) (*openai.AdminOrganizationSpendLimitUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationSpendLimitUpdateParams{
+		Currency:        mergeDiagnostics(openaiparam.FromEnum(path.Root("currency"), data.Currency, openai.AdminOrganizationSpendLimitUpdateParamsCurrencyUsd))(&diags),
+		Interval:        mergeDiagnostics(openaiparam.FromEnum(path.Root("interval"), data.Interval, openai.AdminOrganizationSpendLimitUpdateParamsIntervalMonth))(&diags),
+		ThresholdAmount: data.ThresholdAmount.ValueInt64(),
+	}, diags
+}
+
 type SpendLimitResourceModel struct {
 	Currency        supertypes.StringValue                                                   `tfsdk:"currency"`
 	Interval        supertypes.StringValue                                                   `tfsdk:"interval"`
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/openai/openai-go/v3"
+)
+
+type fakePrivateState map[string][]byte
+
+func (s fakePrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return s[key], nil
+}
+
+func (s fakePrivateState) SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics {
+	s[key] = value
+	return nil
+}
+
+func TestOriginal(t *testing.T) {
+	ctx := t.Context()
+	private := fakePrivateState{}
+
+	original, diags := getOriginal[openai.ProjectRateLimit](ctx, private)
+	if diags.HasError() {
+		t.Fatalf("getOriginal: %v", diags)
+	} else if original != nil {
+		t.Fatalf("getOriginal = %+v, want nil before setOriginal", original)
+	}
+
+	var rateLimit openai.ProjectRateLimit
+	if err := json.Unmarshal([]byte(`{"object":"project.rate_limit","id":"rl-gpt-4o","model":"gpt-4o","max_requests_per_1_minute":500,"max_tokens_per_1_minute":30000,"max_images_per_1_minute":50}`), &rateLimit); err != nil {
+		t.Fatal(err)
+	}
+	if diags := setOriginal(ctx, private, &rateLimit); diags.HasError() {
+		t.Fatalf("setOriginal: %v", diags)
+	}
+
+	original, diags = getOriginal[openai.ProjectRateLimit](ctx, private)
+	if diags.HasError() {
+		t.Fatalf("getOriginal: %v", diags)
+	} else if original == nil {
+		t.Fatal("getOriginal = nil, want the stored rate limit")
+	}
+
+	params, diags := (&ProjectRateLimitResource{}).getResetParams(ctx, ProjectRateLimitResourceModel{}, original)
+	if diags.HasError() {
+		t.Fatalf("getResetParams: %v", diags)
+	}
+	got, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"max_images_per_1_minute":50,"max_requests_per_1_minute":500,"max_tokens_per_1_minute":30000}`
+	if string(got) != want {
+		t.Errorf("getResetParams = %s, want %s", got, want)
+	}
+}
+
+func TestUserRoleResetParams(t *testing.T) {
+	ctx := t.Context()
+	r := &UserRoleResource{}
+
+	params, diags := r.getResetParams(ctx, UserRoleResourceModel{}, nil)
+	if diags.HasError() {
+		t.Fatalf("getResetParams: %v", diags)
+	} else if params != nil {
+		t.Errorf("getResetParams = %+v, want nil when the original role is unknown", params)
+	}
+
+	params, diags = r.getResetParams(ctx, UserRoleResourceModel{}, &openai.OrganizationUser{Role: "owner"})
+	if diags.HasError() {
+		t.Fatalf("getResetParams: %v", diags)
+	} else if params == nil || params.Role.Value != "owner" {
+		t.Errorf("getResetParams = %+v, want the original role owner", params)
+	}
+}
+
+func TestResetToDefaultsDelete_ReadOnly(t *testing.T) {
+	ctx := t.Context()
+
+	for _, readOnly := range []bool{false, true} {
+		r := &DataRetentionResource{}
+		r.readOnly = readOnly
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		state := tfsdk.State{
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			Schema: schemaResp.Schema,
+		}
+		if diags := state.Set(ctx, &DataRetentionResourceModel{}); diags.HasError() {
+			t.Fatalf("state.Set: %v", diags)
+		}
+
+		// Without the original settings, Delete has no request to send.
+		var resp resource.DeleteResponse
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &resp)
+		if got := resp.Diagnostics.HasError(); got != readOnly {
+			t.Errorf("read-only %t: Delete has error = %t, want %t: %v", readOnly, got, readOnly, resp.Diagnostics)
+		}
+	}
+}
+
+func TestReadAfterCreate(t *testing.T) {
+	ctx := t.Context()
+	private := fakePrivateState{}
+
+	if newReadAfterCreate(ctx, private).retry(ctx, true) {
+		t.Error("retry = true without a creation time, want false")
+	}
+
+	if diags := setCreated(ctx, private); diags.HasError() {
+		t.Fatalf("setCreated: %v", diags)
+	}
+	w := newReadAfterCreate(ctx, private)
+	w.delay = time.Millisecond
+	if w.retry(ctx, false) {
+		t.Error("retry = true after the object was found, want false")
+	}
+	if !w.retry(ctx, true) {
+		t.Error("retry = false just after the object was created, want true")
+	}
+
+	w.deadline = time.Now()
+	if w.retry(ctx, true) {
+		t.Error("retry = true after the deadline, want false")
+	}
+}
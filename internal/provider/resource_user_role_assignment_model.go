@@ -24,13 +24,3 @@ func (m *UserRoleAssignmentResourceModel) Fill(ctx context.Context, data any) di
 		return diags
 	}
 }
-
-func (r *UserRoleAssignmentResource) getNewParams(ctx context.Context, data UserRoleAssignmentResourceModel) (*openai.AdminOrganizationUserRoleNewParams, diag.Diagnostics) {
-	return &openai.AdminOrganizationUserRoleNewParams{
-		RoleID: data.RoleId.ValueString(),
-	}, nil
-}
-
-func (r *UserRoleAssignmentResource) getUpdateParams(ctx context.Context, data UserRoleAssignmentResourceModel) (*openai.AdminOrganizationUserRoleNewParams, diag.Diagnostics) {
-	return r.getNewParams(ctx, data)
-}
@@ -15,6 +15,8 @@ import (
 )
 
 func TestAccUserRoleAssignmentResource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "openai_user_role_assignment.test"
 	roleName := sdkacctest.RandomWithPrefix("tf-role")
 
@@ -23,7 +25,7 @@ func TestAccUserRoleAssignmentResource(t *testing.T) {
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccUserRoleAssignmentResourceConfig(acctest.TestUserId, roleName),
+				Config: testAccUserRoleAssignmentResourceConfig(user.ID, roleName),
 				ConfigStateChecks: []statecheck.StateCheck{
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.NotNull()),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role_id"), knownvalue.NotNull()),
@@ -39,7 +41,7 @@ func TestAccUserRoleAssignmentResource(t *testing.T) {
 					}
 					userId := rs.Primary.Attributes["user_id"]
 					roleId := rs.Primary.Attributes["role_id"]
-					return tfutils.BuildTwoPartId(userId, roleId), nil
+					return tfutils.NewCompositeID("user_id", "role_id").Build(userId, roleId), nil
 				},
 			},
 		},
@@ -4,14 +4,15 @@ package provider
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
 	"github.com/openai/openai-go/v3"
 	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
@@ -33,7 +34,7 @@ func (r *UserRoleResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *UserRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Modifies a user's role in the organization.\n\n**NOTE:** The new `openai_user_role_assignment` resource supports predefined roles like `owner` and `reader` as well as custom roles. This resource may be removed in a future release.",
+		MarkdownDescription: "Modifies a user's role in the organization. Destroying the resource restores the role from before it was created, if known.\n\n**NOTE:** The new `openai_user_role_assignment` resource supports predefined roles like `owner` and `reader` as well as custom roles. This resource may be removed in a future release.",
 		Attributes: map[string]schema.Attribute{
 			"user_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the user.",
@@ -60,6 +61,18 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	// The settings read before the resource is created are restored by Delete.
+	original, err := r.readOriginal(ctx, data)
+	if err != nil {
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "read", err))
+		return
+	} else if original != nil {
+		resp.Diagnostics.Append(setOriginal(ctx, resp.Private, original)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	body, diags := r.getNewParams(ctx, data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -68,7 +81,7 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 
 	modelInstance, err := r.client.Admin.Organization.Users.Update(ctx, data.UserId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -81,6 +94,11 @@ func (r *UserRoleResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *UserRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -91,14 +109,18 @@ func (r *UserRoleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	// The object may not be readable yet if it was just created.
 	modelInstance, err := r.client.Admin.Organization.Users.Get(ctx, data.UserId.ValueString())
+	for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+		modelInstance, err = r.client.Admin.Organization.Users.Get(ctx, data.UserId.ValueString())
+	}
 	if err != nil {
 		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -129,7 +151,7 @@ func (r *UserRoleResource) Update(ctx context.Context, req resource.UpdateReques
 
 	modelInstance, err := r.client.Admin.Organization.Users.Update(ctx, data.UserId.ValueString(), *body)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
 		return
 	} else if modelInstance == nil {
 		resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -145,7 +167,61 @@ func (r *UserRoleResource) Update(ctx context.Context, req resource.UpdateReques
 }
 
 func (r *UserRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddWarning("Not Supported", "Delete is not supported for this resource. Please manually delete the resource.")
+	var data UserRoleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	original, diags := getOriginal[openai.OrganizationUser](ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := r.getResetParams(ctx, data, original)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	} else if body == nil {
+		if r.refuseReadOnly(&resp.Diagnostics, "remove the resource from the Terraform state without resetting its settings") {
+			return
+		}
+
+		resp.Diagnostics.AddWarning("Settings Not Reset", "The settings from before this resource was created are unknown, e.g. because it was imported, and there is no documented default to reset them to. The resource was removed from the Terraform state and the settings were left as they are.")
+		return
+	}
+
+	_, err := r.client.Admin.Organization.Users.Update(ctx, data.UserId.ValueString(), *body)
+	if err != nil {
+		if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
+			return
+		}
+
+		resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
+		return
+	}
+}
+
+// readOriginal reads the settings that Delete restores.
+func (r *UserRoleResource) readOriginal(ctx context.Context, data UserRoleResourceModel) (*openai.OrganizationUser, error) {
+	modelInstance, err := r.client.Admin.Organization.Users.Get(ctx, data.UserId.ValueString())
+	if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	return modelInstance, err
+}
+
+func (r *UserRoleResource) getNewParams(ctx context.Context, data UserRoleResourceModel) (*openai.AdminOrganizationUserUpdateParams, diag.Diagnostics) {
+	return r.getUpdateParams(ctx, data)
+}
+
+func (r *UserRoleResource) getUpdateParams(ctx context.Context, data UserRoleResourceModel) (*openai.AdminOrganizationUserUpdateParams, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	return &openai.AdminOrganizationUserUpdateParams{
+		Role: openai.String(data.Role.ValueString()),
+	}, diags
 }
 
 func (r *UserRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
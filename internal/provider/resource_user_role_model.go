@@ -5,18 +5,23 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/openai/openai-go/v3"
+	supertypes "github.com/orange-cloudavenue/terraform-plugin-framework-supertypes"
 )
 
-func (m UserRoleResourceModel) Fill(ctx context.Context, user openai.OrganizationUser) diag.Diagnostics {
+func (m *UserRoleResourceModel) Fill(ctx context.Context, user openai.OrganizationUser) diag.Diagnostics {
+	m.UserId = supertypes.NewStringValue(user.ID)
+	m.Role = supertypes.NewStringValue(user.Role)
 	return nil
 }
 
-func (r *UserRoleResource) getNewParams(ctx context.Context, data UserRoleResourceModel) (*openai.AdminOrganizationUserUpdateParams, diag.Diagnostics) {
-	return r.getUpdateParams(ctx, data)
-}
-
-func (r *UserRoleResource) getUpdateParams(ctx context.Context, data UserRoleResourceModel) (*openai.AdminOrganizationUserUpdateParams, diag.Diagnostics) {
+// getResetParams restores the original role of the user. The role is left as
+// it is when the original is unknown, since it cannot be told apart from one
+// set outside of Terraform.
+func (r *UserRoleResource) getResetParams(ctx context.Context, data UserRoleResourceModel, original *openai.OrganizationUser) (*openai.AdminOrganizationUserUpdateParams, diag.Diagnostics) {
+	if original == nil || original.Role == "" {
+		return nil, nil
+	}
 	return &openai.AdminOrganizationUserUpdateParams{
-		Role: openai.String(data.Role.ValueString()),
+		Role: openai.String(original.Role),
 	}, nil
 }
@@ -12,6 +12,8 @@ import (
 )
 
 func TestAccUserRoleResource(t *testing.T) {
+	user := acctest.User(t)
+
 	rn := "openai_user_role.test"
 
 	resource.Test(t, resource.TestCase{
@@ -20,22 +22,22 @@ func TestAccUserRoleResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Import existing user role
 			{
-				Config:        testAccUserRoleResourceConfig(acctest.TestUserId, "owner"),
+				Config:        testAccUserRoleResourceConfig(user.ID, "owner"),
 				ResourceName:  rn,
 				ImportState:   true,
-				ImportStateId: acctest.TestUserId,
+				ImportStateId: user.ID,
 			},
 			{
-				Config: testAccUserRoleResourceConfig(acctest.TestUserId, "owner"),
+				Config: testAccUserRoleResourceConfig(user.ID, "owner"),
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(acctest.TestUserId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(user.ID)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("owner")),
 				},
 			},
 			{
-				Config: testAccUserRoleResourceConfig(acctest.TestUserId, "reader"),
+				Config: testAccUserRoleResourceConfig(user.ID, "reader"),
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(acctest.TestUserId)),
+					statecheck.ExpectKnownValue(rn, tfjsonpath.New("user_id"), knownvalue.StringExact(user.ID)),
 					statecheck.ExpectKnownValue(rn, tfjsonpath.New("role"), knownvalue.StringExact("reader")),
 				},
 			},
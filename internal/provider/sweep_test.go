@@ -1,11 +1,20 @@
-package provider
+package provider_test
 
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jianyuan/terraform-provider-openai/internal/acctest"
+	"github.com/jianyuan/terraform-provider-openai/internal/sweepers"
 )
 
+func init() {
+	// The key is read when the sweepers run, since TestMain replaces it with
+	// the key of the mock server.
+	acctest.RegisterSweepers(sweepers.All(func() string {
+		return acctest.TestAdminKey
+	}))
+}
+
 func TestMain(m *testing.M) {
-	resource.TestMain(m)
+	acctest.TestMain(m)
 }
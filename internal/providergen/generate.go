@@ -1,3 +1,6 @@
 package providergen
 
+// The openai-go identifiers referenced by settings.ts are checked before any
+// file is generated.
+//go:generate go run ./sdkcheck
 //go:generate bun run ./index.ts
@@ -1,20 +1,35 @@
 import { match, P } from "ts-pattern";
-import type { Attribute } from "./schema";
+import type { Attribute, ElementType } from "./schema";
 import { camelize } from "inflection";
 
 export function primitiveType(attribute: Pick<Attribute, "type">) {
   return match(attribute.type)
     .with("string", () => "string")
+    .with("int64", () => "int64")
+    .with("float64", () => "float64")
+    .with("bool", () => "bool")
     .otherwise(() => {
       throw new Error(`Unsupported primitive type: ${attribute.type}`);
     });
 }
 
+// elementConverter is the openaiparam function converting an SDK element to
+// the element type of a list, set or map attribute.
+function elementConverter(elementType: ElementType) {
+  return match(elementType)
+    .with("string", () => "openaiparam.String")
+    .with("int64", () => "openaiparam.Int64")
+    .with("float64", () => "openaiparam.Float64")
+    .with("bool", () => "openaiparam.Bool")
+    .exhaustive();
+}
+
 export function modelType(attribute: Attribute, parent: string) {
   return match(attribute)
     .with(
       { type: "list_nested" },
       { type: "set_nested" },
+      { type: "map_nested" },
       () => `${parent}${camelize(attribute.name)}Item`,
     )
     .with(
@@ -73,6 +88,11 @@ export function tfAttributeType(attribute: Attribute, parent: string) {
         (attribute) =>
           `supertypes.NewMapTypeOf[${primitiveType({ type: attribute.elementType })}](ctx)`,
       )
+      .with(
+        { type: "map_nested" },
+        (attribute) =>
+          `supertypes.NewMapNestedObjectTypeOf[${modelType(attribute, parent)}](ctx)`,
+      )
       .otherwise(() => {
         throw new Error(`Unsupported attribute type: ${attribute.type}`);
       })
@@ -130,6 +150,11 @@ export function tfAttributeValueType(attribute: Attribute, parent: string) {
       (attribute) =>
         `supertypes.MapValueOf[${primitiveType({ type: attribute.elementType })}]`,
     )
+    .with(
+      { type: "map_nested" },
+      () =>
+        `supertypes.MapNestedObjectValueOf[${modelType(attribute, parent)}]`,
+    )
     .otherwise(() => {
       throw new Error(`Unsupported attribute type: ${attribute.type}`);
     });
@@ -147,6 +172,7 @@ export function tfSchemaAttributeType({ type }: { type: Attribute["type"] }) {
     .with("set_nested", () => "schema.SetNestedAttribute")
     .with("single_nested", () => "schema.SingleNestedAttribute")
     .with("map", () => "schema.MapAttribute")
+    .with("map_nested", () => "schema.MapNestedAttribute")
     .with("object", () => "schema.ObjectAttribute")
     .exhaustive();
 }
@@ -163,6 +189,7 @@ export function tfValidatorType({ type }: { type: Attribute["type"] }) {
     .with("set_nested", () => "validator.Set")
     .with("single_nested", () => "validator.Object")
     .with("map", () => "validator.Map")
+    .with("map_nested", () => "validator.Map")
     .with("object", () => "validator.Object")
     .exhaustive();
 }
@@ -174,11 +201,12 @@ export function tfPlanModifierType({ type }: { type: Attribute["type"] }) {
     .with("float64", () => "planmodifier.Float64")
     .with("bool", () => "planmodifier.Bool")
     .with("list", () => "planmodifier.List")
-    .with("list_nested", () => "planmodifier.ListNested")
+    .with("list_nested", () => "planmodifier.List")
     .with("set", () => "planmodifier.Set")
-    .with("set_nested", () => "planmodifier.SetNested")
-    .with("single_nested", () => "planmodifier.SingleNested")
+    .with("set_nested", () => "planmodifier.Set")
+    .with("single_nested", () => "planmodifier.Object")
     .with("map", () => "planmodifier.Map")
+    .with("map_nested", () => "planmodifier.Map")
     .with("object", () => "planmodifier.Object")
     .exhaustive();
 }
@@ -242,19 +270,48 @@ export function primitiveToTfAttributeSetter({
       { type: "int64" },
       () => `${destVarName} = supertypes.NewInt64Value(int64(${srcVarName}))`,
     )
+    .with({ type: "float64", nullable: true }, () =>
+      `
+        ${destVarName} = (func() types.Float64 {
+          if ${srcMetaVarName}.Valid() {
+            return types.Float64Value(float64(${srcVarName}))
+          }
+          return types.Float64Null()
+        }())
+      `.trim(),
+    )
     .with(
       { type: "float64" },
-      () =>
-        `${destVarName} = supertypes.NewFloat64Value(float64(${srcVarName}))`,
+      () => `${destVarName} = types.Float64Value(float64(${srcVarName}))`,
+    )
+    .with({ type: "bool", nullable: true }, () =>
+      `
+        ${destVarName} = (func() supertypes.BoolValue {
+          if ${srcMetaVarName}.Valid() {
+            return supertypes.NewBoolValue(bool(${srcVarName}))
+          }
+          return supertypes.NewBoolNull()
+        }())
+      `.trim(),
     )
     .with(
       { type: "bool" },
       () => `${destVarName} = supertypes.NewBoolValue(bool(${srcVarName}))`,
     )
+    .with(
+      { type: "list" },
+      (attribute) =>
+        `${destVarName} = openaiparam.ToList(ctx, ${srcVarName}, ${elementConverter(attribute.elementType)})`,
+    )
     .with(
       { type: "set" },
-      () =>
-        `${destVarName} = supertypes.NewSetValueOfSlice(ctx, lo.Uniq(${srcVarName}))`,
+      (attribute) =>
+        `${destVarName} = openaiparam.ToSet(ctx, ${srcVarName}, ${elementConverter(attribute.elementType)})`,
+    )
+    .with(
+      { type: "map" },
+      (attribute) =>
+        `${destVarName} = mergeDiagnostics(openaiparam.ToMap(ctx, ${srcVarName}, ${elementConverter(attribute.elementType)}))(&diags)`,
     )
     .with(
       { type: "single_nested" },
@@ -265,6 +322,15 @@ export function primitiveToTfAttributeSetter({
           return &model
         }())`,
     )
+    .with(
+      { type: "list_nested", filler: P.nonNullable },
+      (attribute) =>
+        `${destVarName} = supertypes.NewListNestedObjectValueOfValueSlice(ctx, lo.Map(${srcVarName}, func(item ${attribute.filler.model}, _ int) ${modelType(attribute, name)} {
+          var model ${modelType(attribute, name)}
+          diags.Append(model.Fill(ctx, item)...)
+          return model
+        }))`,
+    )
     .with(
       { type: "set_nested", filler: P.nonNullable },
       (attribute) =>
@@ -274,5 +340,18 @@ export function primitiveToTfAttributeSetter({
           return model
         }))`,
     )
-    .exhaustive();
+    .with(
+      { type: "map_nested", filler: P.nonNullable },
+      (attribute) =>
+        `${destVarName} = supertypes.NewMapNestedObjectValueOfValueMap(ctx, lo.MapValues(${srcVarName}, func(item ${attribute.filler.model}, _ string) ${modelType(attribute, name)} {
+          var model ${modelType(attribute, name)}
+          diags.Append(model.Fill(ctx, item)...)
+          return model
+        }))`,
+    )
+    .otherwise((attribute) => {
+      throw new Error(
+        `Unsupported attribute type for filler: ${attribute.type}, set filler.skip or a filler model`,
+      );
+    });
 }
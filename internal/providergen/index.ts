@@ -1,14 +1,37 @@
 import { camelize } from "inflection";
-import { DATASOURCES, RESOURCES } from "./settings";
+import { DATASOURCES, HANDWRITTEN_DATASOURCES, RESOURCES } from "./settings";
 import type { DataSource, Attribute, Resource } from "./schema";
 import { match, P } from "ts-pattern";
 import { parseArgs } from "util";
 import dedent from "dedent";
 import {
+  modelType,
   primitiveAttributeToTf,
   primitiveToTfAttributeSetter,
+  tfAttributeType,
   tfAttributeValueType,
+  tfPlanModifierType,
+  tfSchemaAttributeType,
+  tfValidatorType,
 } from "./go-types";
+import {
+  generateMockRoute,
+  generateMockRoutes,
+  generateMockSchema,
+  mockRouteFile,
+} from "./mock";
+import { resolveResource } from "./openapi";
+import { generateParamsMethods } from "./params";
+import { dataSourceReferences, resourceReferences } from "./references";
+import {
+  generateAccTest,
+  generateExample,
+  generateImportExample,
+  generateSweeper,
+  generateSweepers,
+  GO_SCAFFOLD_MARKER,
+  HCL_SCAFFOLD_MARKER,
+} from "./scaffold";
 
 function generateTerraformAttribute({
   parent,
@@ -33,159 +56,54 @@ function generateTerraformAttribute({
     commonParts.push("Sensitive: true,");
   }
 
-  return match(attribute)
-    .with({ type: "string" }, () => {
-      const parts: string[] = [];
-      parts.push("schema.StringAttribute{");
-      parts.push(...commonParts);
-      parts.push("CustomType: supertypes.StringType{},");
-      if (attribute.validators) {
-        parts.push("Validators: []validator.String{");
-        parts.push(...attribute.validators.map((validator) => `${validator},`));
-        parts.push("},");
-      }
-      if (attribute.planModifiers) {
-        parts.push("PlanModifiers: []planmodifier.String{");
-        parts.push(
-          ...attribute.planModifiers.map((modifier) => `${modifier},`),
-        );
-        parts.push("},");
-      }
-      parts.push("}");
-      return parts.join("\n");
-    })
-    .with({ type: "int64" }, (attribute) => {
-      const parts: string[] = [];
-      parts.push("schema.Int64Attribute{");
-      parts.push(...commonParts);
-      parts.push("CustomType: supertypes.Int64Type{},");
-      if (attribute.validators) {
-        parts.push("Validators: []validator.Int64{");
-        parts.push(...attribute.validators.map((validator) => `${validator},`));
-        parts.push("},");
-      }
-      if (attribute.planModifiers) {
-        parts.push("PlanModifiers: []planmodifier.Int64{");
-        parts.push(
-          ...attribute.planModifiers.map((modifier) => `${modifier},`),
-        );
-        parts.push("},");
-      }
-      parts.push("}");
-      return parts.join("\n");
-    })
-    .with({ type: "bool" }, () => {
-      const parts: string[] = [];
-      parts.push("schema.BoolAttribute{");
-      parts.push(...commonParts);
-      parts.push("CustomType: supertypes.BoolType{},");
-      if (attribute.validators) {
-        parts.push("Validators: []validator.Bool{");
-        parts.push(...attribute.validators.map((validator) => `${validator},`));
-        parts.push("},");
-      }
-      if (attribute.planModifiers) {
-        parts.push("PlanModifiers: []planmodifier.Bool{");
-        parts.push(
-          ...attribute.planModifiers.map((modifier) => `${modifier},`),
-        );
-        parts.push("},");
-      }
-      parts.push("}");
-      return parts.join("\n");
-    })
-    .with({ type: "list", elementType: "string" }, (attribute) => {
-      const parts: string[] = [];
-      parts.push("schema.ListAttribute{");
-      parts.push(...commonParts);
-      parts.push("CustomType: supertypes.NewListTypeOf[string](ctx),");
-      if (attribute.validators) {
-        parts.push("Validators: []validator.List{");
-        parts.push(...attribute.validators.map((validator) => `${validator},`));
-        parts.push("},");
-      }
-      if (attribute.planModifiers) {
-        parts.push("PlanModifiers: []planmodifier.List{");
-        parts.push(
-          ...attribute.planModifiers.map((modifier) => `${modifier},`),
-        );
-        parts.push("},");
-      }
-      parts.push("}");
-      return parts.join("\n");
-    })
-    .with({ type: "set", elementType: "string" }, (attribute) => {
-      const parts: string[] = [];
-      parts.push("schema.SetAttribute{");
-      parts.push(...commonParts);
-      parts.push("CustomType: supertypes.NewSetTypeOf[string](ctx),");
-      if (attribute.validators) {
-        parts.push("Validators: []validator.Set{");
-        parts.push(...attribute.validators.map((validator) => `${validator},`));
-        parts.push("},");
-      }
-      if (attribute.planModifiers) {
-        parts.push("PlanModifiers: []planmodifier.Set{");
-        parts.push(
-          ...attribute.planModifiers.map((modifier) => `${modifier},`),
-        );
+  const parts: string[] = [];
+  parts.push(`${tfSchemaAttributeType(attribute)}{`);
+  parts.push(...commonParts);
+  parts.push(`CustomType: ${tfAttributeType(attribute, parent)},`);
+  if (attribute.validators) {
+    parts.push(`Validators: []${tfValidatorType(attribute)}{`);
+    parts.push(...attribute.validators.map((validator) => `${validator},`));
+    parts.push("},");
+  }
+  if (attribute.planModifiers) {
+    parts.push(`PlanModifiers: []${tfPlanModifierType(attribute)}{`);
+    parts.push(...attribute.planModifiers.map((modifier) => `${modifier},`));
+    parts.push("},");
+  }
+
+  const nestedAttributes = (attributes: Array<Attribute>) => [
+    "Attributes: map[string]schema.Attribute{",
+    ...attributes.map(
+      (nestedAttribute) =>
+        `"${nestedAttribute.name}": ${generateTerraformAttribute({
+          parent: modelType(attribute, parent),
+          attribute: nestedAttribute,
+        })},`,
+    ),
+    "},",
+  ];
+  match(attribute)
+    .with(
+      { type: "list_nested" },
+      { type: "set_nested" },
+      { type: "map_nested" },
+      (attribute) => {
+        parts.push("NestedObject: schema.NestedAttributeObject{");
+        parts.push(...nestedAttributes(attribute.attributes));
         parts.push("},");
-      }
-      parts.push("}");
-      return parts.join("\n");
-    })
-    .with({ type: "set_nested" }, (attribute) => {
-      const parts: string[] = [];
-      parts.push("schema.SetNestedAttribute{");
-      parts.push(...commonParts);
-      parts.push(
-        `CustomType: supertypes.NewSetNestedObjectTypeOf[${parent}${camelize(
-          attribute.name,
-        )}Item](ctx),`,
-      );
-      parts.push("NestedObject: schema.NestedAttributeObject{");
-      parts.push("Attributes: map[string]schema.Attribute{");
-      for (const nestedAttribute of attribute.attributes) {
-        parts.push(
-          `"${nestedAttribute.name}": ${generateTerraformAttribute({
-            parent: attribute.name,
-            attribute: nestedAttribute,
-          })},`,
-        );
-      }
-      parts.push("},");
-      parts.push("},");
-      parts.push("}");
-      return parts.join("\n");
-    })
+      },
+    )
     .with({ type: "single_nested" }, (attribute) => {
-      const parts: string[] = [];
-      parts.push("schema.SingleNestedAttribute{");
-      parts.push(...commonParts);
-      parts.push(
-        `CustomType: supertypes.NewSingleNestedObjectTypeOf[${parent}${camelize(
-          attribute.name,
-        )}](ctx),`,
+      parts.push(...nestedAttributes(attribute.attributes));
+    })
+    .with({ type: "object" }, () => {
+      throw new Error(
+        `Unsupported attribute type: object (${attribute.name}), use single_nested`,
       );
-      if (attribute.validators) {
-        parts.push("Validators: []validator.Object{");
-        parts.push(...attribute.validators.map((validator) => `${validator},`));
-        parts.push("},");
-      }
-      parts.push("Attributes: map[string]schema.Attribute{");
-      for (const nestedAttribute of attribute.attributes) {
-        parts.push(
-          `"${nestedAttribute.name}": ${generateTerraformAttribute({
-            parent: `${parent}${camelize(attribute.name)}`,
-            attribute: nestedAttribute,
-          })},`,
-        );
-      }
-      parts.push("},");
-      parts.push("}");
-      return parts.join("\n");
     })
-    .exhaustive();
+    .otherwise(() => {});
+  parts.push("}");
+  return parts.join("\n");
 }
 
 function generateTerraformToPrimitive({
@@ -199,8 +117,11 @@ function generateTerraformToPrimitive({
   return match(attribute)
     .with({ type: "string" }, () => `${srcVarName}.ValueString()`)
     .with({ type: "int64" }, () => `${srcVarName}.ValueInt64()`)
+    .with({ type: "float64" }, () => `${srcVarName}.ValueFloat64()`)
     .with({ type: "bool" }, () => `${srcVarName}.ValueBool()`)
-    .exhaustive();
+    .otherwise(() => {
+      throw new Error(`Unsupported primitive attribute: ${attribute.type}`);
+    });
 }
 
 function generateModel({
@@ -236,9 +157,21 @@ function generateModel({
 
     extras.push(
       ...match(attribute)
-        .with({ type: "set_nested" }, (attribute) => [
+        .with(
+          { type: "list_nested" },
+          { type: "set_nested" },
+          { type: "map_nested" },
+          (attribute) => [
+            generateModel({
+              name: modelType(attribute, name),
+              attributes: attribute.attributes,
+              filler: attribute.filler,
+            }),
+          ],
+        )
+        .with({ type: "single_nested" }, (attribute) => [
           generateModel({
-            name: `${name}${camelize(attribute.name)}Item`,
+            name: modelType(attribute, name),
             attributes: attribute.attributes,
             filler: attribute.filler,
           }),
@@ -385,7 +318,7 @@ function generateDataSource({ dataSource }: { dataSource: DataSource }) {
     }
 
     if err := iter.Err(); err != nil {
-      resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+      resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Config.Schema, "read", err))
       return
     }
 
@@ -400,7 +333,7 @@ function generateDataSource({ dataSource }: { dataSource: DataSource }) {
       (api) => `
     modelInstance, err := d.client.${api.readMethod}(${readRequestParams.join(",")})
     if err != nil {
-      resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+      resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Config.Schema, "read", err))
       return
     } else if modelInstance == nil {
       resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -421,6 +354,7 @@ package provider
 
 import (
   "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+  intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
   "github.com/openai/openai-go/v3"
 )
 
@@ -583,12 +517,43 @@ function generateResource({ resource }: { resource: Resource }) {
     );
   }
 
+  const deleteStrategy =
+    resource.api.deleteStrategy ??
+    (resource.api.deleteMethod ? "api_delete" : undefined);
+  if (!deleteStrategy) {
+    throw new Error(
+      `Resource ${resource.name} has neither a deleteMethod nor a deleteStrategy`,
+    );
+  } else if (deleteStrategy === "api_delete" && !resource.api.deleteMethod) {
+    throw new Error(
+      `Resource ${resource.name} has the api_delete strategy but no deleteMethod`,
+    );
+  } else if (
+    deleteStrategy === "reset_to_defaults" &&
+    !resource.api.updateMethod
+  ) {
+    throw new Error(
+      `Resource ${resource.name} has the reset_to_defaults strategy but no updateMethod`,
+    );
+  }
+
+  const originalModel =
+    resource.api.readModel !== undefined
+      ? `openai.${resource.api.readModel}`
+      : resource.filler?.model;
+  if (deleteStrategy === "reset_to_defaults" && !originalModel) {
+    throw new Error(
+      `Resource ${resource.name} has the reset_to_defaults strategy but neither a readModel nor a filler`,
+    );
+  }
+
   return `
 // Code generated by providergen. DO NOT EDIT.
 package provider
 
 import (
   "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+  intdiag "github.com/jianyuan/terraform-provider-openai/internal/diag"
   "github.com/openai/openai-go/v3"
 )
 
@@ -624,6 +589,24 @@ func (r *${resourceName}) Create(ctx context.Context, req resource.CreateRequest
     return
   }
 
+  ${
+    deleteStrategy === "reset_to_defaults"
+      ? dedent`
+      // The settings read before the resource is created are restored by Delete.
+      original, err := r.readOriginal(ctx, data)
+      if err != nil {
+        resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "read", err))
+        return
+      } else if original != nil {
+        resp.Diagnostics.Append(setOriginal(ctx, resp.Private, original)...)
+        if resp.Diagnostics.HasError() {
+          return
+        }
+      }
+      `
+      : ""
+  }
+
   body, diags := r.getNewParams(ctx, data)
   resp.Diagnostics.Append(diags...)
   if resp.Diagnostics.HasError() {
@@ -632,7 +615,7 @@ func (r *${resourceName}) Create(ctx context.Context, req resource.CreateRequest
 
   modelInstance, err := r.client.${resource.api.method}.${resource.api.createMethod}(${createRequestParams.join(",")})
   if err != nil {
-    resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create, got error: %s", err))
+    resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "create", err))
     return
   } else if modelInstance == nil {
     resp.Diagnostics.AddError("Client Error", "Unable to create, got empty response body")
@@ -645,6 +628,11 @@ func (r *${resourceName}) Create(ctx context.Context, req resource.CreateRequest
   }
 
   resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+  if resp.Diagnostics.HasError() {
+    return
+  }
+
+  resp.Diagnostics.Append(setCreated(ctx, resp.Private)...)
 }
 
 func (r *${resourceName}) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -663,19 +651,24 @@ func (r *${resourceName}) Read(ctx context.Context, req resource.ReadRequest, re
           Limit: openai.Int(100),
         }
 
-        var modelInstance *openai.${api.readModel}
-
-        iter := r.client.${api.method}.${api.readMethod}(${readRequestParams.join(",")})
-        for iter.Next() {
-          currentModelInstance := iter.Current()
-          if r.resourceMatch(data, currentModelInstance){
-            modelInstance = new(currentModelInstance)
-            break
+        findModelInstance := func() (*openai.${api.readModel}, error) {
+          iter := r.client.${api.method}.${api.readMethod}(${readRequestParams.join(",")})
+          for iter.Next() {
+            currentModelInstance := iter.Current()
+            if r.resourceMatch(data, currentModelInstance){
+              return new(currentModelInstance), nil
+            }
           }
+          return nil, iter.Err()
         }
 
-        if err := iter.Err(); err != nil {
-          resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+        // The object may not be listed yet if it was just created.
+        modelInstance, err := findModelInstance()
+        for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, err == nil && modelInstance == nil); {
+          modelInstance, err = findModelInstance()
+        }
+        if err != nil {
+          resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
           return
         } else if modelInstance == nil {
           resp.State.RemoveResource(ctx)
@@ -685,14 +678,18 @@ func (r *${resourceName}) Read(ctx context.Context, req resource.ReadRequest, re
     )
     .otherwise(
       (api) => `
-        modelInstance, err := r.client.${api.method}.Get(${readRequestParams.join(",")})
+        // The object may not be readable yet if it was just created.
+        modelInstance, err := r.client.${api.method}.${api.readMethod}(${readRequestParams.join(",")})
+        for wait := newReadAfterCreate(ctx, req.Private); wait.retry(ctx, isNotFound(err)); {
+          modelInstance, err = r.client.${api.method}.${api.readMethod}(${readRequestParams.join(",")})
+        }
         if err != nil {
           if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
             resp.State.RemoveResource(ctx)
             return
           }
 
-          resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read, got error: %s", err))
+          resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "read", err))
           return
         } else if modelInstance == nil {
           resp.Diagnostics.AddError("Client Error", "Unable to read, got empty response body")
@@ -728,7 +725,7 @@ func (r *${resourceName}) Update(ctx context.Context, req resource.UpdateRequest
 
       modelInstance, err := r.client.${resource.api.method}.${resource.api.updateMethod}(${updateRequestParams.join(",")})
       if err != nil {
-        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update, got error: %s", err))
+        resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.Plan.Schema, "update", err))
         return
       } else if modelInstance == nil {
         resp.Diagnostics.AddError("Client Error", "Unable to update, got empty response body")
@@ -749,9 +746,10 @@ func (r *${resourceName}) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *${resourceName}) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-  ${
-    resource.api.deleteMethod
-      ? dedent`
+  ${match(deleteStrategy)
+    .with(
+      "api_delete",
+      () => dedent`
       var data ${modelName}
 
       resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -765,16 +763,103 @@ func (r *${resourceName}) Delete(ctx context.Context, req resource.DeleteRequest
           return
         }
 
-        resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete, got error: %s", err))
+        resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
         return
       }
-      `
-      : dedent`
-      resp.Diagnostics.AddWarning("Not Supported", "Delete is not supported for this resource. Please manually delete the resource.")
-      `
-  }
+      `,
+    )
+    .with(
+      "reset_to_defaults",
+      () => dedent`
+      var data ${modelName}
+
+      resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+      if resp.Diagnostics.HasError() {
+        return
+      }
+
+      original, diags := getOriginal[${originalModel}](ctx, req.Private)
+      resp.Diagnostics.Append(diags...)
+      if resp.Diagnostics.HasError() {
+        return
+      }
+
+      body, diags := r.getResetParams(ctx, data, original)
+      resp.Diagnostics.Append(diags...)
+      if resp.Diagnostics.HasError() {
+        return
+      } else if body == nil {
+        if r.refuseReadOnly(&resp.Diagnostics, "remove the resource from the Terraform state without resetting its settings") {
+          return
+        }
+
+        resp.Diagnostics.AddWarning("Settings Not Reset", "The settings from before this resource was created are unknown, e.g. because it was imported, and there is no documented default to reset them to. The resource was removed from the Terraform state and the settings were left as they are.")
+        return
+      }
+
+      _, err := r.client.${resource.api.method}.${resource.api.updateMethod}(${updateRequestParams.join(",")})
+      if err != nil {
+        if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
+          return
+        }
+
+        resp.Diagnostics.Append(intdiag.NewClientError(ctx, req.State.Schema, "delete", err))
+        return
+      }
+      `,
+    )
+    .with(
+      "remove_from_state",
+      () => dedent`
+      // The API has nothing to delete. The resource is only removed from the
+      // Terraform state.
+      if r.refuseReadOnly(&resp.Diagnostics, "remove the resource from the Terraform state") {
+        return
+      }
+      `,
+    )
+    .exhaustive()}
 }
 
+${
+  deleteStrategy === "reset_to_defaults"
+    ? match(resource.api)
+        .with(
+          { readStrategy: "paginate" },
+          (api) => `
+            // readOriginal reads the settings that Delete restores.
+            func (r *${resourceName}) readOriginal(ctx context.Context, data ${modelName}) (*${originalModel}, error) {
+              params := openai.${api.readRequestParamsStruct}{
+                Limit: openai.Int(100),
+              }
+
+              iter := r.client.${api.method}.${api.readMethod}(${readRequestParams.join(",")})
+              for iter.Next() {
+                if current := iter.Current(); r.resourceMatch(data, current) {
+                  return new(current), nil
+                }
+              }
+              return nil, iter.Err()
+            }
+          `,
+        )
+        .otherwise(
+          (api) => `
+            // readOriginal reads the settings that Delete restores.
+            func (r *${resourceName}) readOriginal(ctx context.Context, data ${modelName}) (*${originalModel}, error) {
+              modelInstance, err := r.client.${api.method}.${api.readMethod}(${readRequestParams.join(",")})
+              if apiErr, ok := errors.AsType[*openai.Error](err); ok && apiErr.StatusCode == http.StatusNotFound {
+                return nil, nil
+              }
+              return modelInstance, err
+            }
+          `,
+        )
+    : ""
+}
+
+${generateParamsMethods(resource)}
+
 ${match(resource.importStateAttributes)
   .with([P.any], (attributes) => {
     return `
@@ -783,42 +868,22 @@ ${match(resource.importStateAttributes)
         }
       `;
   })
-  .with([P.any, P.any], (attributes) => {
+  .with(P.array(P.string), (attributes) => {
+    const separators = (resource.importStateAlternateSeparators ?? [])
+      .map((separator) => `'${separator}'`)
+      .join(", ");
     return `
         func (r *${resourceName}) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-          first, second, err := tfutils.SplitTwoPartId(req.ID, "${attributes[0]}", "${attributes[1]}")
+          id := tfutils.NewCompositeID(${attributes.map((attribute) => JSON.stringify(attribute)).join(", ")})${separators ? `.WithAlternateSeparators(${separators})` : ""}
+          parts, err := id.Parse(req.ID)
           if err != nil {
             resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
             return
           }
 
-          resp.Diagnostics.Append(resp.State.SetAttribute(
-            ctx, path.Root("${attributes[0]}"), first,
-          )...)
-          resp.Diagnostics.Append(resp.State.SetAttribute(
-            ctx, path.Root("${attributes[1]}"), second,
-          )...)
-        }
-      `;
-  })
-  .with([P.any, P.any, P.any], (attributes) => {
-    return `
-        func (r *${resourceName}) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-          first, second, third, err := tfutils.SplitThreePartId(req.ID, "${attributes[0]}", "${attributes[1]}", "${attributes[2]}")
-          if err != nil {
-            resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Error parsing ID: %s", err.Error()))
-            return
+          for i, name := range id.Names() {
+            resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(name), parts[i])...)
           }
-
-          resp.Diagnostics.Append(resp.State.SetAttribute(
-            ctx, path.Root("${attributes[0]}"), first,
-          )...)
-          resp.Diagnostics.Append(resp.State.SetAttribute(
-            ctx, path.Root("${attributes[1]}"), second,
-          )...)
-          resp.Diagnostics.Append(resp.State.SetAttribute(
-            ctx, path.Root("${attributes[2]}"), third,
-          )...)
         }
       `;
   })
@@ -828,12 +893,71 @@ ${generateResourceModel({ resource })}
 `;
 }
 
+function generateFillTests({
+  resources,
+  dataSources,
+}: {
+  resources: Array<Resource>;
+  dataSources: Array<DataSource>;
+}) {
+  const fillTests = [
+    ...dataSources.map((dataSource) => ({
+      kind: "data_source" as const,
+      name: dataSource.name,
+      filler: dataSource.filler,
+    })),
+    ...resources.map((resource) => ({
+      kind: "resource" as const,
+      name: resource.name,
+      filler: resource.filler,
+    })),
+  ]
+    .filter(({ filler }) => filler)
+    .sort((a, b) =>
+      `${a.kind}_${a.name}`.localeCompare(`${b.kind}_${b.name}`),
+    )
+    .map(({ kind, name, filler }) => {
+      const typeName = `${camelize(name)}${kind === "resource" ? "Resource" : "DataSource"}`;
+      const modelName = `${typeName}Model`;
+      const stateFunction =
+        kind === "resource" ? "resourceState" : "dataSourceState";
+
+      return `{
+        name: "${kind}_${name}",
+        run: func(t *testing.T, name string) {
+          testFill(t, name, ${stateFunction}(t, New${typeName}()), func(ctx context.Context, m *${modelName}, data ${filler!.model}) diag.Diagnostics {
+            return m.Fill(ctx, data)
+          })
+        },
+      },`;
+    });
+
+  return `
+// Code generated by providergen. DO NOT EDIT.
+package provider
+
+import (
+  "github.com/openai/openai-go/v3"
+)
+
+// generatedFillTests returns the Fill tests of the data sources and resources
+// with a filler in internal/providergen/settings.ts.
+func generatedFillTests() []fillTest {
+  return []fillTest{
+    ${fillTests.join("\n")}
+  }
+}
+`;
+}
+
 function generateProvider({
   resources,
   dataSources,
+  handwrittenDataSources,
 }: {
   resources: Array<Resource>;
   dataSources: Array<DataSource>;
+  handwrittenDataSources: Array<string>;
 }) {
   return `
 // Code generated by providergen. DO NOT EDIT.
@@ -850,9 +974,9 @@ func (p *OpenAIProvider) Resources(ctx context.Context) []func() resource.Resour
 
 func (p *OpenAIProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		${dataSources
-      .sort((a, b) => a.name.localeCompare(b.name))
-      .map((dataSource) => `New${camelize(dataSource.name)}DataSource,`)
+		${[...dataSources.map((dataSource) => dataSource.name), ...handwrittenDataSources]
+      .sort((a, b) => a.localeCompare(b))
+      .map((name) => `New${camelize(name)}DataSource,`)
       .join("\n")}
 	}
 }
@@ -865,6 +989,29 @@ async function writeAndFormatGoFile(destination: URL, code: string) {
   await Bun.$`go tool goimports -w ${destination.pathname}`;
 }
 
+async function writeAndFormatTsFile(destination: URL, code: string) {
+  await Bun.write(destination, code);
+  await Bun.$`bun x prettier --write ${destination.pathname}`;
+}
+
+// writeScaffold writes a scaffold unless it exists without the marker on its
+// first line, i.e. it has been taken over by hand.
+async function writeScaffold(destination: URL, code: string) {
+  const file = Bun.file(destination);
+  if (await file.exists()) {
+    const [firstLine] = (await file.text()).split("\n", 1);
+    if (firstLine !== GO_SCAFFOLD_MARKER && firstLine !== HCL_SCAFFOLD_MARKER) {
+      return;
+    }
+  }
+
+  if (destination.pathname.endsWith(".go")) {
+    await writeAndFormatGoFile(destination, code);
+  } else {
+    await Bun.write(destination, code);
+  }
+}
+
 async function main() {
   const { values } = parseArgs({
     args: Bun.argv,
@@ -872,11 +1019,27 @@ async function main() {
       filter: {
         type: "string",
       },
+      references: {
+        type: "boolean",
+      },
     },
     strict: true,
     allowPositionals: true,
   });
 
+  const resources = RESOURCES.map(resolveResource);
+
+  if (values.references) {
+    // Print the SDK references for ./sdkcheck instead of generating code.
+    console.log(
+      JSON.stringify([
+        ...DATASOURCES.flatMap(dataSourceReferences),
+        ...resources.flatMap(resourceReferences),
+      ]),
+    );
+    return;
+  }
+
   console.log("Generating data sources...");
   for (const dataSource of DATASOURCES) {
     if (values.filter && values.filter !== dataSource.name) {
@@ -894,7 +1057,7 @@ async function main() {
   }
 
   console.log("Generating resources...");
-  for (const resource of RESOURCES) {
+  for (const resource of resources) {
     if (values.filter && values.filter !== resource.name) {
       continue;
     }
@@ -904,13 +1067,78 @@ async function main() {
       new URL(`../provider/resource_${resource.name}_gen.go`, import.meta.url),
       code,
     );
+
+    await writeScaffold(
+      new URL(
+        `../../examples/resources/openai_${resource.name}/resource.tf`,
+        import.meta.url,
+      ),
+      generateExample({ resource }),
+    );
+    if (resource.importStateAttributes) {
+      await writeScaffold(
+        new URL(
+          `../../examples/resources/openai_${resource.name}/import.sh`,
+          import.meta.url,
+        ),
+        generateImportExample({ resource }),
+      );
+    }
+    await writeScaffold(
+      new URL(`../provider/resource_${resource.name}_test.go`, import.meta.url),
+      generateAccTest({ resource }),
+    );
+    if (resource.sweeper) {
+      await writeScaffold(
+        new URL(`../sweepers/${resource.name}.go`, import.meta.url),
+        generateSweeper({ resource }),
+      );
+    }
   }
+  await writeAndFormatGoFile(
+    new URL("../provider/fill_gen_test.go", import.meta.url),
+    generateFillTests({ resources, dataSources: DATASOURCES }),
+  );
+  await writeAndFormatGoFile(
+    new URL("../sweepers/sweepers_gen.go", import.meta.url),
+    generateSweepers({ resources }),
+  );
+
+  console.log("Generating mock server...");
+  for (const resource of resources) {
+    if ("route" in resource.mock) {
+      const route = `../mockserver/routes/${resource.mock.route}.ts`;
+      if (!(await Bun.file(new URL(route, import.meta.url)).exists())) {
+        throw new Error(
+          `resource ${resource.name}: hand-written mock route ${route} does not exist`,
+        );
+      }
+      continue;
+    }
+
+    await writeAndFormatTsFile(
+      new URL(
+        `../mockserver/generated/routes/${mockRouteFile(resource)}.ts`,
+        import.meta.url,
+      ),
+      generateMockRoute(resource),
+    );
+  }
+  await writeAndFormatTsFile(
+    new URL("../mockserver/generated/db-schema.ts", import.meta.url),
+    generateMockSchema(resources),
+  );
+  await writeAndFormatTsFile(
+    new URL("../mockserver/generated/routes.ts", import.meta.url),
+    generateMockRoutes(resources),
+  );
 
   console.log("Generating provider...");
   {
     const code = generateProvider({
-      resources: RESOURCES,
+      resources,
       dataSources: DATASOURCES,
+      handwrittenDataSources: HANDWRITTEN_DATASOURCES,
     });
     await writeAndFormatGoFile(
       new URL(`../provider/provider_gen.go`, import.meta.url),
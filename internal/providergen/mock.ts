@@ -0,0 +1,450 @@
+import {
+  camelize,
+  dasherize,
+  humanize,
+  pluralize,
+  singularize,
+} from "inflection";
+import { match } from "ts-pattern";
+import type {
+  Attribute,
+  ElementType,
+  GeneratedResourceMock,
+  Resource,
+} from "./schema";
+
+export const TS_GENERATED_MARKER =
+  "// Code generated by providergen. DO NOT EDIT.";
+
+// generatedMock returns the generated mock of a resource, or undefined when
+// it is served by hand-written routes.
+export function generatedMock(
+  resource: Resource,
+): GeneratedResourceMock | undefined {
+  return "path" in resource.mock ? resource.mock : undefined;
+}
+
+// mockTableName is the name of the drizzle table of a resource, e.g.
+// spendAlerts.
+export function mockTableName(resource: Resource) {
+  return camelize(pluralize(resource.name), true);
+}
+
+// mockRouteFile is the file name of the generated routes of a resource, e.g.
+// spend-alerts.
+export function mockRouteFile(resource: Resource) {
+  return dasherize(pluralize(resource.name));
+}
+
+function pathParameters(path: string) {
+  return [...path.matchAll(/:(\w+)/g)].map((match) => match[1]!);
+}
+
+// parentCollection is the collection a path parameter identifies an object
+// of, i.e. the segment preceding it, e.g. projects for :project_id in
+// /organization/projects/:project_id/spend_alerts.
+function parentCollection(path: string, parameter: string) {
+  const segments = path.split("/");
+  const collection = segments[segments.indexOf(`:${parameter}`) - 1];
+  if (!collection) {
+    throw new Error(`${path}: no collection before :${parameter}`);
+  }
+  return collection;
+}
+
+// parentTableName is the drizzle table of a parent collection. It must be
+// declared in internal/mockserver/db-schema.ts.
+function parentTableName(path: string, parameter: string) {
+  return camelize(parentCollection(path, parameter), true);
+}
+
+// enumValues returns the values of a `stringvalidator.OneOf` validator of the
+// attribute. The references check matches them with the enum constants of its
+// params fields, so that the mock accepts the values the SDK does.
+export function enumValues(attribute: Attribute): Array<string> | undefined {
+  for (const validator of attribute.validators ?? []) {
+    const oneOf = validator.match(/^stringvalidator\.OneOf\((.*)\)$/s);
+    if (oneOf) {
+      return JSON.parse(`[${oneOf[1]}]`);
+    }
+  }
+  return undefined;
+}
+
+function isConfigurable(attribute: Attribute) {
+  return attribute.computedOptionalRequired !== "computed";
+}
+
+function elementObjectType(elementType: ElementType) {
+  return match(elementType)
+    .with("string", () => "string")
+    .with("int64", "float64", () => "number")
+    .with("bool", () => "boolean")
+    .exhaustive();
+}
+
+// objectType is the TypeScript type of the JSON stored for an attribute.
+function objectType(attribute: Attribute): string {
+  return match(attribute)
+    .with({ type: "string" }, (attribute) => {
+      const values = enumValues(attribute);
+      return values
+        ? values.map((value) => JSON.stringify(value)).join(" | ")
+        : "string";
+    })
+    .with({ type: "int64" }, { type: "float64" }, () => "number")
+    .with({ type: "bool" }, () => "boolean")
+    .with(
+      { type: "list" },
+      { type: "set" },
+      (attribute) => `${elementObjectType(attribute.elementType)}[]`,
+    )
+    .with(
+      { type: "map" },
+      (attribute) =>
+        `Record<string, ${elementObjectType(attribute.elementType)}>`,
+    )
+    .with(
+      { type: "single_nested" },
+      { type: "object" },
+      (attribute) => `{ ${nestedObjectType(attribute.attributes)} }`,
+    )
+    .with(
+      { type: "list_nested" },
+      { type: "set_nested" },
+      (attribute) => `Array<{ ${nestedObjectType(attribute.attributes)} }>`,
+    )
+    .with(
+      { type: "map_nested" },
+      (attribute) =>
+        `Record<string, { ${nestedObjectType(attribute.attributes)} }>`,
+    )
+    .exhaustive();
+}
+
+function nestedObjectType(attributes: Array<Attribute>) {
+  return attributes
+    .map((attribute) => {
+      const optional = attribute.computedOptionalRequired !== "required";
+      return `${attribute.name}${optional ? "?" : ""}: ${objectType(attribute)}${attribute.nullable ? " | null" : ""};`;
+    })
+    .join(" ");
+}
+
+// column builds the drizzle column of an attribute. Configured attributes
+// store the request, and computed ones must be nullable or creation
+// timestamps, since nothing else would set them.
+function column(resource: Resource, attribute: Attribute): string {
+  const json = (type: string) => `text({ mode: "json" }).$type<${type}>()`;
+  const base = match(attribute)
+    .with({ type: "string" }, (attribute) => {
+      const values = enumValues(attribute);
+      return values ? `text({ enum: ${JSON.stringify(values)} })` : "text()";
+    })
+    .with({ type: "int64" }, () => "integer()")
+    .with({ type: "float64" }, () => "real()")
+    .with({ type: "bool" }, () => `integer({ mode: "boolean" })`)
+    .otherwise((attribute) => json(objectType(attribute)));
+
+  return match(attribute.computedOptionalRequired)
+    .with("required", () => `${base}.notNull()`)
+    .with("optional", () => base)
+    .with("computed", () => {
+      if (attribute.nullable) {
+        return base;
+      }
+      if (attribute.type === "int64" && attribute.name.endsWith("_at")) {
+        return `${base}.notNull().$defaultFn(now)`;
+      }
+      throw new Error(
+        `resource ${resource.name}: the generated mock cannot compute attribute ${attribute.name}, use a hand-written route`,
+      );
+    })
+    .with("computed_optional", () => {
+      throw new Error(
+        `resource ${resource.name}: the generated mock has no default for attribute ${attribute.name}, use a hand-written route`,
+      );
+    })
+    .exhaustive();
+}
+
+function elementValidator(elementType: ElementType) {
+  return match(elementType)
+    .with("string", () => "z.string()")
+    .with("int64", () => "z.number().int()")
+    .with("float64", () => "z.number()")
+    .with("bool", () => "z.boolean()")
+    .exhaustive();
+}
+
+// validator builds the zod validator of a configurable attribute.
+function validator(attribute: Attribute): string {
+  const base = match(attribute)
+    .with({ type: "string" }, (attribute) => {
+      const values = enumValues(attribute);
+      return values ? `z.enum(${JSON.stringify(values)})` : "z.string()";
+    })
+    .with({ type: "int64" }, () => "z.number().int()")
+    .with({ type: "float64" }, () => "z.number()")
+    .with({ type: "bool" }, () => "z.boolean()")
+    .with(
+      { type: "list" },
+      { type: "set" },
+      (attribute) => `z.array(${elementValidator(attribute.elementType)})`,
+    )
+    .with(
+      { type: "map" },
+      (attribute) =>
+        `z.record(z.string(), ${elementValidator(attribute.elementType)})`,
+    )
+    .with(
+      { type: "single_nested" },
+      { type: "object" },
+      (attribute) => objectValidator(attribute.attributes),
+    )
+    .with(
+      { type: "list_nested" },
+      { type: "set_nested" },
+      (attribute) => `z.array(${objectValidator(attribute.attributes)})`,
+    )
+    .with(
+      { type: "map_nested" },
+      (attribute) =>
+        `z.record(z.string(), ${objectValidator(attribute.attributes)})`,
+    )
+    .exhaustive();
+
+  return attribute.computedOptionalRequired === "required"
+    ? base
+    : `${base}.nullish()`;
+}
+
+function objectValidator(attributes: Array<Attribute>) {
+  return `z.object({
+    ${attributes
+      .filter(isConfigurable)
+      .map((attribute) => `${attribute.name}: ${validator(attribute)},`)
+      .join("\n")}
+  })`;
+}
+
+// checkMock checks that the path parameters of a generated mock match the
+// attributes the provider reads the resource with.
+function checkMock(resource: Resource, mock: GeneratedResourceMock) {
+  const parameters = pathParameters(mock.path);
+  const want = [...parameters, "id"];
+  const got = resource.api.readRequestAttributes ?? [];
+  if (want.join(",") !== got.join(",")) {
+    throw new Error(
+      `resource ${resource.name}: mock path ${mock.path} needs readRequestAttributes [${want.join(", ")}], got [${got.join(", ")}]`,
+    );
+  }
+}
+
+// generateMockSchema generates the drizzle tables of the resources with a
+// generated mock. internal/mockserver/db-schema.ts re-exports them.
+export function generateMockSchema(resources: Array<Resource>) {
+  const mocked = resources.filter((resource) => generatedMock(resource));
+  const parentTables = new Set(
+    mocked.flatMap((resource) => {
+      const mock = generatedMock(resource)!;
+      return pathParameters(mock.path).map((parameter) =>
+        parentTableName(mock.path, parameter),
+      );
+    }),
+  );
+
+  const tables = mocked.map((resource) => {
+    const mock = generatedMock(resource)!;
+    checkMock(resource, mock);
+    const parameters = pathParameters(mock.path);
+    const columns = resource.attributes
+      .filter(
+        (attribute) =>
+          attribute.name !== "id" && !parameters.includes(attribute.name),
+      )
+      .map((attribute) => `${attribute.name}: ${column(resource, attribute)},`);
+
+    return `
+export const ${mockTableName(resource)} = sqliteTable(${JSON.stringify(pluralize(resource.name))}, {
+  object: text({ enum: [${JSON.stringify(mock.object)}] }).notNull().default(${JSON.stringify(mock.object)}),
+  id: text().primaryKey().$defaultFn(idGenerator(${JSON.stringify(mock.idPrefix)})),
+  ${parameters
+    .map(
+      (parameter) =>
+        `${parameter}: text().notNull().references(() => ${parentTableName(mock.path, parameter)}.id, { onDelete: "cascade" }),`,
+    )
+    .join("\n")}
+  ${columns.join("\n")}
+});
+`;
+  });
+
+  const body = tables.join("");
+  const used = (names: Array<string>) =>
+    names.filter((name) => new RegExp(`\\b${name}\\b`).test(body));
+
+  return `${TS_GENERATED_MARKER}
+import { ${used(["integer", "real", "sqliteTable", "text"]).join(", ")} } from "drizzle-orm/sqlite-core";
+${parentTables.size > 0 ? `import { ${[...parentTables].sort().join(", ")} } from "../db-schema";` : ""}
+import { ${used(["idGenerator", "now"]).join(", ")} } from "../db-utils";
+${body}`;
+}
+
+// generateMockRoute generates the create, read, update, delete and list
+// routes of a resource with a generated mock.
+export function generateMockRoute(resource: Resource) {
+  const mock = generatedMock(resource)!;
+  checkMock(resource, mock);
+  const table = `schema.${mockTableName(resource)}`;
+  const parameters = pathParameters(mock.path);
+  const label = humanize(resource.name, true);
+  const notFound = `c.json({ error: ${JSON.stringify(
+    `${humanize(resource.name)} not found`,
+  )} }, 404)`;
+
+  const where = (conditions: Array<string>) =>
+    conditions.length === 1 ? conditions[0]! : `and(${conditions.join(", ")})`;
+  const parentConditions = parameters.map(
+    (parameter) => `eq(${table}.${parameter}, c.req.param("${parameter}")!)`,
+  );
+  const objectConditions = [
+    ...parentConditions,
+    `eq(${table}.id, c.req.param("id"))`,
+  ];
+
+  const parentChecks = parameters.map((parameter) => {
+    const parentTable = parentTableName(mock.path, parameter);
+    const parentLabel = humanize(
+      singularize(parentCollection(mock.path, parameter)),
+    );
+    return `
+route.use(async (c, next) => {
+  const ${parameter} = c.req.param("${parameter}");
+  const parent = ${parameter} && await db.query.${parentTable}.findFirst({
+    where: eq(schema.${parentTable}.id, ${parameter}),
+  });
+  if (!parent) {
+    return c.json({ error: ${JSON.stringify(`${parentLabel} not found`)} }, 404);
+  }
+
+  await next();
+});
+`;
+  });
+
+  return `${TS_GENERATED_MARKER}
+import { zValidator } from "@hono/zod-validator";
+import { ${parameters.length > 0 ? "and, " : ""}eq } from "drizzle-orm";
+import { Hono } from "hono";
+import z from "zod";
+import { db } from "../../db";
+import * as schema from "../../db-schema";
+import { paginate, paginationQuery } from "../../pagination";
+
+const requestSchema = ${objectValidator(
+    resource.attributes.filter(
+      (attribute) => !parameters.includes(attribute.name),
+    ),
+  )};
+
+type Row = typeof ${table}.$inferSelect;
+
+${
+  parameters.length > 0
+    ? `// serialize drops the path parameters, which are not part of the object.
+function serialize({ ${parameters.map((parameter) => `${parameter}: _${parameter}`).join(", ")}, ...object }: Row) {
+  return object;
+}`
+    : `function serialize(row: Row) {
+  return row;
+}`
+}
+
+const route = new Hono();
+${parentChecks.join("")}
+route.get("/", zValidator("query", paginationQuery), async (c) => {
+  const rows = await db.select().from(${table})${parentConditions.length > 0 ? `.where(${where(parentConditions)})` : ""};
+
+  return c.json(
+    paginate(rows.map(serialize), c.req.valid("query"), {
+      cursor: (object) => object.id,${mock.pagination ? `\nstyle: ${JSON.stringify(mock.pagination)},` : ""}
+    }),
+  );
+});
+
+route.post("/", zValidator("json", requestSchema), async (c) => {
+  const [row] = await db
+    .insert(${table})
+    .values({
+      ${parameters.map((parameter) => `${parameter}: c.req.param("${parameter}")!,`).join("\n")}
+      ...c.req.valid("json"),
+    })
+    .returning();
+  if (!row) {
+    return c.json({ error: ${JSON.stringify(`Failed to create ${label}`)} }, 500);
+  }
+
+  return c.json(serialize(row));
+});
+
+route.get("/:id", async (c) => {
+  const [row] = await db
+    .select()
+    .from(${table})
+    .where(${where(objectConditions)});
+  if (!row) {
+    return ${notFound};
+  }
+
+  return c.json(serialize(row));
+});
+
+route.post("/:id", zValidator("json", requestSchema.partial()), async (c) => {
+  const [row] = await db
+    .update(${table})
+    .set(c.req.valid("json"))
+    .where(${where(objectConditions)})
+    .returning();
+  if (!row) {
+    return ${notFound};
+  }
+
+  return c.json(serialize(row));
+});
+
+route.delete("/:id", async (c) => {
+  const [row] = await db
+    .delete(${table})
+    .where(${where(objectConditions)})
+    .returning();
+  if (!row) {
+    return ${notFound};
+  }
+
+  return c.json({
+    object: ${JSON.stringify(`${mock.object}.deleted`)},
+    id: row.id,
+    deleted: true,
+  });
+});
+
+export default route;
+`;
+}
+
+// generateMockRoutes generates the list of generated routes that
+// internal/mockserver/index.ts mounts.
+export function generateMockRoutes(resources: Array<Resource>) {
+  const mocked = resources.filter((resource) => generatedMock(resource));
+
+  return `${TS_GENERATED_MARKER}
+import type { Hono } from "hono";
+${mocked.map((resource) => `import ${mockTableName(resource)} from "./routes/${mockRouteFile(resource)}";`).join("\n")}
+
+// routes are the generated routes by collection path.
+export const routes: Array<[path: string, route: Hono]> = [
+  ${mocked.map((resource) => `[${JSON.stringify(generatedMock(resource)!.path)}, ${mockTableName(resource)}],`).join("\n")}
+];
+`;
+}
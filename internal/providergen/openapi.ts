@@ -0,0 +1,327 @@
+import { match } from "ts-pattern";
+import spec from "./openapi/admin.json";
+import type {
+  Attribute,
+  AttributeOverride,
+  ComputedOptionalRequired,
+  ElementType,
+  Resource,
+  ResourceSettings,
+} from "./schema";
+
+export interface SchemaObject {
+  $ref?: string;
+  type?: string | Array<string>;
+  format?: string;
+  description?: string;
+  enum?: Array<unknown>;
+  nullable?: boolean;
+  properties?: Record<string, SchemaObject>;
+  required?: Array<string>;
+  items?: SchemaObject;
+  additionalProperties?: boolean | SchemaObject;
+  anyOf?: Array<SchemaObject>;
+  oneOf?: Array<SchemaObject>;
+  allOf?: Array<SchemaObject>;
+}
+
+interface OpenApiDocument {
+  components: {
+    schemas: Record<string, SchemaObject>;
+  };
+}
+
+const document = spec as unknown as OpenApiDocument;
+
+// Properties that every API object carries and that are never exposed as
+// attributes.
+const IGNORED_PROPERTIES = ["object"];
+
+export function getSchema(name: string): SchemaObject {
+  const schema = document.components.schemas[name];
+  if (!schema) {
+    throw new Error(`Schema ${name} not found in openapi/admin.json`);
+  }
+  return schema;
+}
+
+// deref follows a $ref, keeping the description of the referencing schema
+// and flattening allOf into a single object schema.
+function deref(schema: SchemaObject): SchemaObject {
+  if (schema.$ref) {
+    const { $ref, ...rest } = schema;
+    const target = deref(getSchema($ref.replace("#/components/schemas/", "")));
+    return { ...target, ...rest };
+  }
+  if (schema.allOf) {
+    const { allOf, ...rest } = schema;
+    return allOf.map(deref).reduce(
+      (acc, part) => ({
+        ...acc,
+        ...part,
+        properties: { ...acc.properties, ...part.properties },
+        required: [...(acc.required ?? []), ...(part.required ?? [])],
+      }),
+      rest,
+    );
+  }
+  return schema;
+}
+
+// unwrapNullable strips the null member of `anyOf`/`oneOf`/`type` unions, as
+// well as the OpenAPI 3.0 `nullable` keyword.
+function unwrapNullable(schema: SchemaObject): {
+  schema: SchemaObject;
+  nullable: boolean;
+} {
+  const resolved = deref(schema);
+  const variants = resolved.anyOf ?? resolved.oneOf;
+  if (variants) {
+    const nonNull = variants.filter((variant) => variant.type !== "null");
+    if (nonNull.length === 1 && nonNull[0]) {
+      const { anyOf, oneOf, ...rest } = resolved;
+      return {
+        schema: { ...deref(nonNull[0]), ...rest },
+        nullable: nonNull.length !== variants.length,
+      };
+    }
+  }
+  if (Array.isArray(resolved.type)) {
+    const types = resolved.type.filter((type) => type !== "null");
+    if (types.length === 1) {
+      return {
+        schema: { ...resolved, type: types[0] },
+        nullable: types.length !== resolved.type.length,
+      };
+    }
+  }
+  return { schema: resolved, nullable: resolved.nullable ?? false };
+}
+
+// additionalProperties returns the value schema of a map-like object schema.
+function additionalProperties(schema: SchemaObject): SchemaObject | undefined {
+  return typeof schema.additionalProperties === "object"
+    ? unwrapNullable(schema.additionalProperties).schema
+    : undefined;
+}
+
+function attributeType(path: string, schema: SchemaObject): Attribute["type"] {
+  return match(schema)
+    .with({ type: "string" }, () => "string" as const)
+    .with({ type: "integer" }, () => "int64" as const)
+    .with({ type: "number" }, () => "float64" as const)
+    .with({ type: "boolean" }, () => "bool" as const)
+    .with({ type: "array" }, (schema) => {
+      const items = schema.items && unwrapNullable(schema.items).schema;
+      return items?.type === "object" ? ("set_nested" as const) : ("set" as const);
+    })
+    .with({ type: "object" }, (schema) => {
+      if (schema.properties) {
+        return "single_nested" as const;
+      }
+      return additionalProperties(schema)?.type === "object"
+        ? ("map_nested" as const)
+        : ("map" as const);
+    })
+    .otherwise(() => {
+      throw new Error(`${path}: unsupported schema ${JSON.stringify(schema)}`);
+    });
+}
+
+// elementType returns the element type of an array or map-like object
+// schema. Elements without a type, e.g. free-form values, are strings.
+function elementType(path: string, schema: SchemaObject): ElementType {
+  const element =
+    schema.type === "array"
+      ? schema.items && unwrapNullable(schema.items).schema
+      : additionalProperties(schema);
+  if (!element?.type) {
+    return "string";
+  }
+  const type = attributeType(path, element);
+  if (
+    type !== "string" &&
+    type !== "int64" &&
+    type !== "float64" &&
+    type !== "bool"
+  ) {
+    throw new Error(`${path}: unsupported element type ${type}`);
+  }
+  return type;
+}
+
+// nestedSchema returns the object schema holding the attributes of a nested
+// attribute.
+function nestedSchema(schema: SchemaObject): SchemaObject {
+  const { schema: resolved } = unwrapNullable(schema);
+  if (resolved.type === "array" && resolved.items) {
+    return unwrapNullable(resolved.items).schema;
+  }
+  return (!resolved.properties && additionalProperties(resolved)) || resolved;
+}
+
+function normalizeDescription(description: string | undefined) {
+  const trimmed = description?.trim() ?? "";
+  return trimmed === "" || /[.!?]$/.test(trimmed) ? trimmed : `${trimmed}.`;
+}
+
+function enumValidator(values: Array<unknown>) {
+  return `stringvalidator.OneOf(${values.map((value) => JSON.stringify(value)).join(", ")})`;
+}
+
+interface SchemaSet {
+  response?: SchemaObject;
+  create?: SchemaObject;
+  update?: SchemaObject;
+}
+
+// deriveAttribute builds an attribute from the response, create and update
+// schemas of a property:
+//
+//   - the type, description and enum of the response property win over those
+//     of the request properties, so that the attribute describes the state;
+//   - a property required by the create request is required, one accepted by
+//     either request is optional and the rest are computed, unless the
+//     override says otherwise;
+//   - nullable response properties are nullable when computed, since the
+//     value of a configured attribute comes from the configuration;
+//   - enums become a `stringvalidator.OneOf` unless the attribute is computed;
+//   - properties missing from the response are skipped by the filler.
+function deriveAttribute(
+  path: string,
+  name: string,
+  schemas: SchemaSet,
+  override?: AttributeOverride,
+): Partial<Attribute> {
+  const property = (schema?: SchemaObject) =>
+    schema?.properties?.[name] && unwrapNullable(schema.properties[name]);
+  const response = property(schemas.response);
+  const create = property(schemas.create);
+  const update = property(schemas.update);
+  const source = response ?? create ?? update;
+  if (!source) {
+    return {};
+  }
+
+  const computedOptionalRequired: ComputedOptionalRequired =
+    override?.computedOptionalRequired ??
+    (create && schemas.create?.required?.includes(name)
+      ? "required"
+      : create || update
+        ? "optional"
+        : "computed");
+  const type = attributeType(path, source.schema);
+  const values = [response, create, update].find(
+    (candidate) => candidate?.schema.enum,
+  )?.schema.enum;
+
+  return {
+    name,
+    type,
+    description: normalizeDescription(
+      [response, create, update].find(
+        (candidate) => candidate?.schema.description,
+      )?.schema.description,
+    ),
+    computedOptionalRequired,
+    ...(type === "set" || type === "map"
+      ? { elementType: elementType(path, source.schema) }
+      : {}),
+    ...(computedOptionalRequired === "computed" && response?.nullable
+      ? { nullable: true }
+      : {}),
+    ...(computedOptionalRequired !== "computed" && type === "string" && values
+      ? { validators: [enumValidator(values)] }
+      : {}),
+    ...(schemas.response && !response ? { filler: { skip: true } } : {}),
+  } as Partial<Attribute>;
+}
+
+function resolveAttributes(
+  path: string,
+  overrides: Array<AttributeOverride>,
+  schemas: SchemaSet,
+  exclude: Array<string> = [],
+): Array<Attribute> {
+  const names = [
+    ...overrides.map((override) => override.name),
+    ...[schemas.response, schemas.create, schemas.update].flatMap((schema) =>
+      Object.keys(schema?.properties ?? {}),
+    ),
+  ].filter(
+    (name, index, names) =>
+      names.indexOf(name) === index &&
+      !IGNORED_PROPERTIES.includes(name) &&
+      !exclude.includes(name),
+  );
+
+  return names.map((name) => {
+    const attributePath = `${path}.${name}`;
+    const override = overrides.find((override) => override.name === name);
+    const derived = deriveAttribute(attributePath, name, schemas, override);
+
+    const attribute = { ...derived, ...override } as AttributeOverride;
+    if (derived.filler && override?.filler) {
+      attribute.filler = { ...derived.filler, ...override.filler };
+    }
+
+    if (
+      attribute.type === "single_nested" ||
+      attribute.type === "set_nested" ||
+      attribute.type === "list_nested" ||
+      attribute.type === "map_nested"
+    ) {
+      const nested = (schema?: SchemaObject) =>
+        schema?.properties?.[name] && nestedSchema(schema.properties[name]);
+      attribute.attributes = resolveAttributes(
+        attributePath,
+        override?.attributes ?? [],
+        {
+          response: nested(schemas.response),
+          create: nested(schemas.create),
+          update: nested(schemas.update),
+        },
+      );
+    }
+
+    return assertAttribute(attributePath, attribute);
+  });
+}
+
+function assertAttribute(path: string, attribute: AttributeOverride): Attribute {
+  for (const key of ["type", "description", "computedOptionalRequired"]) {
+    if (!(key in attribute) || attribute[key as keyof AttributeOverride] === undefined) {
+      throw new Error(
+        `${path}: ${key} is not set and the attribute is not in the OpenAPI spec`,
+      );
+    }
+  }
+  return attribute as Attribute;
+}
+
+// resolveResource merges the attribute overrides of a resource with the
+// attributes derived from the OpenAPI schemas it is bound to. Attributes
+// listed in settings.ts come first, in order, followed by the remaining
+// properties of the schemas.
+export function resolveResource(resource: ResourceSettings): Resource {
+  const { openapi, attributes, ...rest } = resource;
+  return {
+    ...rest,
+    attributes: resolveAttributes(
+      resource.name,
+      attributes,
+      openapi
+        ? {
+            response: deref(getSchema(openapi.schema)),
+            create: openapi.createRequest
+              ? deref(getSchema(openapi.createRequest))
+              : undefined,
+            update: openapi.updateRequest
+              ? deref(getSchema(openapi.updateRequest))
+              : undefined,
+          }
+        : {},
+      openapi?.exclude,
+    ),
+  };
+}
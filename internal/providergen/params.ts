@@ -0,0 +1,268 @@
+import { camelize } from "inflection";
+import { match } from "ts-pattern";
+import { modelType } from "./go-types";
+import type {
+  Attribute,
+  ParamsField,
+  Resource,
+  ResourceParams,
+} from "./schema";
+
+export function paramsAttribute(
+  context: string,
+  attributes: Array<Attribute>,
+  field: ParamsField,
+): Attribute {
+  const attribute = attributes.find(
+    (attribute) => attribute.name === field.attribute,
+  );
+  if (!attribute) {
+    throw new Error(`${context}: unknown attribute ${field.attribute}`);
+  }
+  return attribute;
+}
+
+export function paramsFieldName(field: ParamsField) {
+  return field.field ?? camelize(field.attribute);
+}
+
+// primitiveValue converts a primitive attribute. Plain fields take the value,
+// param.Opt fields are omitted when the value is null, unless opt is "zero".
+function primitiveValue(
+  context: string,
+  attribute: Attribute,
+  field: ParamsField,
+  value: string,
+): string {
+  const [from, wrap, getter] = match(attribute.type)
+    .with("string", () => ["FromString", "String", "ValueString"])
+    .with("int64", () => ["FromInt64", "Int", "ValueInt64"])
+    .with("float64", () => ["FromFloat64", "Float", "ValueFloat64"])
+    .with("bool", () => ["FromBool", "Bool", "ValueBool"])
+    .otherwise(() => {
+      throw new Error(
+        `${context}: opt is not supported by ${attribute.type} attributes`,
+      );
+    });
+
+  return match(field.opt)
+    .with(true, () => `openaiparam.${from}(${value})`)
+    .with("zero", () => `openai.${wrap}(${value}.${getter}())`)
+    .with(undefined, () => `${value}.${getter}()`)
+    .exhaustive();
+}
+
+// nestedConverter returns the function converting an object of a nested
+// attribute to its params struct.
+function nestedConverter({
+  context,
+  attribute,
+  field,
+  parent,
+  pathExpr,
+}: {
+  context: string;
+  attribute: Attribute & { attributes: Array<Attribute> };
+  field: ParamsField;
+  parent: string;
+  pathExpr: string;
+}) {
+  if (!field.struct || !field.fields) {
+    throw new Error(
+      `${context}: the params of nested attributes need a struct and fields`,
+    );
+  }
+  // The objects of collections have no attribute path of their own, so their
+  // errors are reported on the collection.
+  const childPath =
+    attribute.type === "single_nested"
+      ? (name: string) => `${pathExpr}.AtName(${JSON.stringify(name)})`
+      : () => pathExpr;
+  const model = modelType(attribute, parent);
+  return `func(ctx context.Context, data *${model}) (openai.${field.struct}, diag.Diagnostics) {
+    var diags diag.Diagnostics
+    return openai.${field.struct}{
+      ${paramsFields({
+        context,
+        attributes: attribute.attributes,
+        fields: field.fields,
+        parent: model,
+        childPath,
+      }).join("\n")}
+    }, diags
+  }`;
+}
+
+function paramsValue({
+  context,
+  attribute,
+  field,
+  parent,
+  pathExpr,
+}: {
+  context: string;
+  attribute: Attribute;
+  field: ParamsField;
+  parent: string;
+  pathExpr: string;
+}): string {
+  const value = `data.${camelize(attribute.name)}`;
+
+  if (field.enum) {
+    if (attribute.type !== "string" || field.opt) {
+      throw new Error(`${context}: enum is only supported by plain string fields`);
+    }
+    return `mergeDiagnostics(openaiparam.FromEnum(${pathExpr}, ${value}, ${field.enum
+      .map((constant) => `openai.${constant}`)
+      .join(", ")}))(&diags)`;
+  }
+  if (field.opt && !["string", "int64", "float64", "bool"].includes(attribute.type)) {
+    throw new Error(
+      `${context}: opt is not supported by ${attribute.type} attributes`,
+    );
+  }
+
+  return match(attribute)
+    .with(
+      { type: "string" },
+      { type: "int64" },
+      { type: "float64" },
+      { type: "bool" },
+      (attribute) => primitiveValue(context, attribute, field, value),
+    )
+    .with(
+      { type: "list" },
+      () => `mergeDiagnostics(openaiparam.FromList(ctx, ${value}))(&diags)`,
+    )
+    .with(
+      { type: "set" },
+      () => `mergeDiagnostics(openaiparam.FromSet(ctx, ${value}))(&diags)`,
+    )
+    .with(
+      { type: "map" },
+      () => `mergeDiagnostics(openaiparam.FromMap(ctx, ${value}))(&diags)`,
+    )
+    .with(
+      { type: "single_nested" },
+      (attribute) =>
+        `mergeDiagnostics(openaiparam.FromSingleNested(ctx, ${value}, ${nestedConverter(
+          { context, attribute, field, parent, pathExpr },
+        )}))(&diags)`,
+    )
+    .with(
+      { type: "list_nested" },
+      (attribute) =>
+        `mergeDiagnostics(openaiparam.FromListNested(ctx, ${value}, ${nestedConverter(
+          { context, attribute, field, parent, pathExpr },
+        )}))(&diags)`,
+    )
+    .with(
+      { type: "set_nested" },
+      (attribute) =>
+        `mergeDiagnostics(openaiparam.FromSetNested(ctx, ${value}, ${nestedConverter(
+          { context, attribute, field, parent, pathExpr },
+        )}))(&diags)`,
+    )
+    .with(
+      { type: "map_nested" },
+      (attribute) =>
+        `mergeDiagnostics(openaiparam.FromMapNested(ctx, ${value}, ${nestedConverter(
+          { context, attribute, field, parent, pathExpr },
+        )}))(&diags)`,
+    )
+    .with({ type: "object" }, () => {
+      throw new Error(`${context}: object attributes are not supported`);
+    })
+    .exhaustive();
+}
+
+// paramsFields returns the struct fields of the params. childPath returns
+// the attribute path expression of an attribute, for error messages.
+function paramsFields({
+  context,
+  attributes,
+  fields,
+  parent,
+  childPath,
+}: {
+  context: string;
+  attributes: Array<Attribute>;
+  fields: Array<ParamsField>;
+  parent: string;
+  childPath: (name: string) => string;
+}): Array<string> {
+  return fields.map((field) => {
+    const fieldContext = `${context}: field ${field.attribute}`;
+    const attribute = paramsAttribute(fieldContext, attributes, field);
+    return `${paramsFieldName(field)}: ${paramsValue({
+      context: fieldContext,
+      attribute,
+      field,
+      parent,
+      pathExpr: childPath(attribute.name),
+    })},`;
+  });
+}
+
+function paramsMethod({
+  resource,
+  method,
+  params,
+}: {
+  resource: Resource;
+  method: "getNewParams" | "getUpdateParams";
+  params: ResourceParams;
+}) {
+  const resourceName = `${camelize(resource.name)}Resource`;
+  const modelName = `${resourceName}Model`;
+  return `
+func (r *${resourceName}) ${method}(ctx context.Context, data ${modelName}) (*openai.${params.struct}, diag.Diagnostics) {
+  var diags diag.Diagnostics
+  return &openai.${params.struct}{
+    ${paramsFields({
+      context: `resource ${resource.name}: ${method}`,
+      attributes: resource.attributes,
+      fields: params.fields,
+      parent: modelName,
+      childPath: (name) => `path.Root(${JSON.stringify(name)})`,
+    }).join("\n")}
+  }, diags
+}
+`;
+}
+
+// generateParamsMethods generates the getNewParams and getUpdateParams
+// methods of the resource that are not hand-written.
+export function generateParamsMethods(resource: Resource) {
+  const { createParams, updateParams } = resource.api;
+  const resourceName = `${camelize(resource.name)}Resource`;
+  const methods: Array<string> = [];
+
+  if (createParams === "updateParams") {
+    if (!updateParams) {
+      throw new Error(
+        `resource ${resource.name}: createParams is "updateParams" but updateParams is not set`,
+      );
+    }
+    methods.push(`
+func (r *${resourceName}) getNewParams(ctx context.Context, data ${resourceName}Model) (*openai.${updateParams.struct}, diag.Diagnostics) {
+  return r.getUpdateParams(ctx, data)
+}
+`);
+  } else if (createParams) {
+    methods.push(
+      paramsMethod({ resource, method: "getNewParams", params: createParams }),
+    );
+  }
+  if (updateParams) {
+    methods.push(
+      paramsMethod({
+        resource,
+        method: "getUpdateParams",
+        params: updateParams,
+      }),
+    );
+  }
+
+  return methods.join("\n");
+}
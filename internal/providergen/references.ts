@@ -0,0 +1,341 @@
+import { camelize } from "inflection";
+import { match } from "ts-pattern";
+import { enumValues } from "./mock";
+import { paramsAttribute, paramsFieldName } from "./params";
+import type {
+  Attribute,
+  DataSource,
+  ParamsField,
+  Resource,
+  ResourceParams,
+} from "./schema";
+
+// Reference is an identifier of the openai-go SDK that the generated code
+// uses. The references are checked by ./sdkcheck before any file is written.
+export type Reference =
+  // method is a method reached from *openai.Client, e.g.
+  // ["Admin", "Organization", "Projects", "New"].
+  | { context: string; kind: "method"; path: Array<string> }
+  // type is a type expression, e.g. "openai.Project" or "[]openai.Group".
+  | { context: string; kind: "type"; type: string }
+  // field is a field path of a type. When set, the field must be of type
+  // `want`, or convertible to the basic type `convertibleTo`.
+  | {
+      context: string;
+      kind: "field";
+      type: string;
+      path: Array<string>;
+      want?: string;
+      convertibleTo?: string;
+    }
+  // constant is a constant, e.g. "openai.AdminOrganizationInviteNewParamsRoleOwner",
+  // that must be of the type of the field path of a type, and whose value
+  // must be one of `values`, the OneOf validator of the attribute.
+  | {
+      context: string;
+      kind: "constant";
+      name: string;
+      type: string;
+      path: Array<string>;
+      values: Array<string>;
+    };
+
+function attributeReferences(
+  context: string,
+  attributes: Array<Attribute>,
+  model: string,
+): Array<Reference> {
+  return attributes.flatMap((attribute) => {
+    if (attribute.filler?.skip) {
+      return [];
+    }
+
+    const attributeContext = `${context}: attribute ${attribute.name}`;
+    const path = Array.isArray(attribute.filler?.sourceAttribute)
+      ? attribute.filler.sourceAttribute
+      : [camelize(attribute.name)];
+    const field = (extra: { want?: string; convertibleTo?: string } = {}) =>
+      ({
+        context: attributeContext,
+        kind: "field",
+        type: model,
+        path,
+        ...extra,
+      }) as const;
+
+    const references: Array<Reference> = match(attribute)
+      .with({ type: "string" }, () => [field({ convertibleTo: "string" })])
+      .with({ type: "int64" }, () => [field({ convertibleTo: "int64" })])
+      .with({ type: "float64" }, () => [field({ convertibleTo: "float64" })])
+      .with({ type: "bool" }, () => [field({ convertibleTo: "bool" })])
+      .with({ type: "list_nested" }, { type: "set_nested" }, (attribute) =>
+        attribute.filler
+          ? [
+              field({ want: `[]${attribute.filler.model}` }),
+              ...attributeReferences(
+                attributeContext,
+                attribute.attributes,
+                attribute.filler.model,
+              ),
+            ]
+          : [field()],
+      )
+      .with({ type: "map_nested" }, (attribute) =>
+        attribute.filler
+          ? [
+              field({ want: `map[string]${attribute.filler.model}` }),
+              ...attributeReferences(
+                attributeContext,
+                attribute.attributes,
+                attribute.filler.model,
+              ),
+            ]
+          : [field()],
+      )
+      .with({ type: "single_nested" }, (attribute) =>
+        attribute.filler
+          ? [
+              field({ want: attribute.filler.model }),
+              ...attributeReferences(
+                attributeContext,
+                attribute.attributes,
+                attribute.filler.model,
+              ),
+            ]
+          : [field()],
+      )
+      .otherwise(() => [field()]);
+
+    if (attribute.nullable) {
+      references.push({
+        context: attributeContext,
+        kind: "field",
+        type: model,
+        path: ["JSON", ...path],
+      });
+    }
+
+    return references;
+  });
+}
+
+// paramsType is the Go type of the params field of an attribute.
+function paramsType(attribute: Attribute, field: ParamsField): string | undefined {
+  if (field.enum) {
+    return undefined;
+  }
+  return match(attribute)
+    .with(
+      { type: "string" },
+      { type: "int64" },
+      { type: "float64" },
+      { type: "bool" },
+      (attribute) =>
+        field.opt ? `param.Opt[${attribute.type}]` : attribute.type,
+    )
+    .with({ type: "list" }, { type: "set" }, (attribute) => `[]${attribute.elementType}`)
+    .with({ type: "map" }, (attribute) => `map[string]${attribute.elementType}`)
+    .with({ type: "single_nested" }, () => `openai.${field.struct}`)
+    .with(
+      { type: "list_nested" },
+      { type: "set_nested" },
+      () => `[]openai.${field.struct}`,
+    )
+    .with({ type: "map_nested" }, () => `map[string]openai.${field.struct}`)
+    .otherwise(() => undefined);
+}
+
+function paramsFieldReferences(
+  context: string,
+  attributes: Array<Attribute>,
+  struct: string,
+  fields: Array<ParamsField>,
+): Array<Reference> {
+  return fields.flatMap((field) => {
+    const fieldContext = `${context}: field ${field.attribute}`;
+    const attribute = paramsAttribute(fieldContext, attributes, field);
+    const type = `openai.${struct}`;
+    const path = [paramsFieldName(field)];
+    const want = paramsType(attribute, field);
+    const values = field.enum ? enumValues(attribute) : undefined;
+    if (field.enum && values?.length !== field.enum.length) {
+      throw new Error(
+        `${fieldContext}: enum needs a stringvalidator.OneOf validator with a value per constant`,
+      );
+    }
+    const references: Array<Reference> = [
+      {
+        context: fieldContext,
+        kind: "field",
+        type,
+        path,
+        ...(want ? { want } : {}),
+      },
+      ...(field.enum ?? []).map(
+        (constant) =>
+          ({
+            context: fieldContext,
+            kind: "constant",
+            name: `openai.${constant}`,
+            type,
+            path,
+            values: values ?? [],
+          }) as const,
+      ),
+    ];
+    if (field.struct && field.fields && "attributes" in attribute) {
+      references.push(
+        ...paramsFieldReferences(
+          fieldContext,
+          attribute.attributes,
+          field.struct,
+          field.fields,
+        ),
+      );
+    }
+    return references;
+  });
+}
+
+function paramsReferences(
+  context: string,
+  attributes: Array<Attribute>,
+  params: ResourceParams,
+): Array<Reference> {
+  return [
+    { context, kind: "type", type: `openai.${params.struct}` },
+    ...paramsFieldReferences(context, attributes, params.struct, params.fields),
+  ];
+}
+
+export function dataSourceReferences(dataSource: DataSource): Array<Reference> {
+  const context = `data source ${dataSource.name}`;
+  const references: Array<Reference> = [
+    {
+      context: `${context}: readMethod`,
+      kind: "method",
+      path: dataSource.api.readMethod.split("."),
+    },
+  ];
+
+  if (dataSource.api.readStrategy === "paginate") {
+    references.push(
+      {
+        context: `${context}: readRequestParamsStruct`,
+        kind: "field",
+        type: `openai.${dataSource.api.readRequestParamsStruct}`,
+        path: ["Limit"],
+      },
+      {
+        context: `${context}: readModel`,
+        kind: "type",
+        type: `openai.${dataSource.api.readModel}`,
+      },
+    );
+  }
+
+  if (dataSource.filler) {
+    references.push(
+      {
+        context: `${context}: filler`,
+        kind: "type",
+        type: dataSource.filler.model,
+      },
+      ...attributeReferences(
+        context,
+        dataSource.attributes,
+        dataSource.filler.model,
+      ),
+    );
+  }
+
+  return references;
+}
+
+export function resourceReferences(resource: Resource): Array<Reference> {
+  const context = `resource ${resource.name}`;
+  const { api } = resource;
+  const method = (name: string, methodName: string | undefined) =>
+    methodName
+      ? [
+          {
+            context: `${context}: ${name}`,
+            kind: "method",
+            path: [...(api.method?.split(".") ?? []), methodName],
+          } as const,
+        ]
+      : [];
+
+  const references: Array<Reference> = [
+    ...method("createMethod", api.createMethod),
+    ...method("readMethod", api.readMethod),
+    ...method("updateMethod", api.updateMethod),
+    ...method("deleteMethod", api.deleteMethod),
+  ];
+
+  if (api.readStrategy === "paginate") {
+    if (api.readRequestParamsStruct) {
+      references.push({
+        context: `${context}: readRequestParamsStruct`,
+        kind: "field",
+        type: `openai.${api.readRequestParamsStruct}`,
+        path: ["Limit"],
+      });
+    }
+  }
+  if (api.readModel) {
+    references.push({
+      context: `${context}: readModel`,
+      kind: "type",
+      type: `openai.${api.readModel}`,
+    });
+  }
+
+  if (api.createParams && api.createParams !== "updateParams") {
+    references.push(
+      ...paramsReferences(
+        `${context}: createParams`,
+        resource.attributes,
+        api.createParams,
+      ),
+    );
+  }
+  if (api.updateParams) {
+    references.push(
+      ...paramsReferences(
+        `${context}: updateParams`,
+        resource.attributes,
+        api.updateParams,
+      ),
+    );
+  }
+
+  if (resource.sweeper) {
+    references.push(
+      ...method("sweeper", "ListAutoPaging"),
+      {
+        context: `${context}: sweeper`,
+        kind: "field",
+        type: `openai.${resource.sweeper.listParamsStruct}`,
+        path: ["Limit"],
+      },
+    );
+  }
+
+  if (resource.filler) {
+    references.push(
+      {
+        context: `${context}: filler`,
+        kind: "type",
+        type: resource.filler.model,
+      },
+      ...attributeReferences(
+        context,
+        resource.attributes,
+        resource.filler.model,
+      ),
+    );
+  }
+
+  return references;
+}
@@ -0,0 +1,430 @@
+import { camelize, pluralize } from "inflection";
+import { match } from "ts-pattern";
+import type { Attribute, Resource } from "./schema";
+
+// Scaffolds are the files that a new resource needs besides its generated
+// code: an example, an acceptance test and a sweeper. They are regenerated as
+// long as their first line is the marker, and left alone once it is deleted.
+export const GO_SCAFFOLD_MARKER =
+  "// Code generated by providergen. Delete this line to edit the file by hand.";
+export const HCL_SCAFFOLD_MARKER =
+  "# Code generated by providergen. Delete this line to edit the file by hand.";
+
+function isConfigurable(attribute: Attribute) {
+  return attribute.computedOptionalRequired !== "computed";
+}
+
+// enumValues returns the values accepted by a `stringvalidator.OneOf`
+// validator of the attribute.
+function enumValues(attribute: Attribute): Array<string> {
+  const validator = attribute.validators?.find((validator) =>
+    validator.startsWith("stringvalidator.OneOf("),
+  );
+  return validator
+    ? [...validator.matchAll(/"([^"]*)"/g)].map(([, value]) => value ?? "")
+    : [];
+}
+
+// exampleValue returns an HCL value of the attribute. Nested attributes only
+// hold their required attributes.
+function exampleValue(attribute: Attribute, indent: string): string {
+  return match(attribute)
+    .with({ type: "string" }, (attribute) => {
+      const [value] = enumValues(attribute);
+      return JSON.stringify(
+        value ??
+          (attribute.name.endsWith("_id")
+            ? `<${attribute.name}>`
+            : `example-${attribute.name.replaceAll("_", "-")}`),
+      );
+    })
+    .with({ type: "int64" }, () => "1")
+    .with({ type: "float64" }, () => "1.0")
+    .with({ type: "bool" }, () => "true")
+    .with({ type: "list" }, { type: "set" }, (attribute) =>
+      match(attribute.elementType)
+        .with("string", () =>
+          attribute.name.includes("recipient") ||
+          attribute.name.includes("email")
+            ? `["user@example.com"]`
+            : `["example"]`,
+        )
+        .with("int64", () => "[1]")
+        .with("float64", () => "[1.0]")
+        .with("bool", () => "[true]")
+        .exhaustive(),
+    )
+    .with({ type: "map" }, () => `{}`)
+    .with({ type: "single_nested" }, { type: "object" }, (attribute) =>
+      exampleObject(attribute.attributes, indent),
+    )
+    .with(
+      { type: "list_nested" },
+      { type: "set_nested" },
+      (attribute) => `[${exampleObject(attribute.attributes, indent)}]`,
+    )
+    .with(
+      { type: "map_nested" },
+      (attribute) =>
+        `{\n${indent}  example = ${exampleObject(attribute.attributes, `${indent}  `)}\n${indent}}`,
+    )
+    .exhaustive();
+}
+
+function exampleObject(attributes: Array<Attribute>, indent: string) {
+  const lines = exampleAttributeLines(
+    attributes.filter(
+      (attribute) => attribute.computedOptionalRequired === "required",
+    ),
+    `${indent}  `,
+    false,
+  );
+  return `{\n${lines.join("\n")}\n${indent}}`;
+}
+
+// exampleAttributeLines returns `name = value` lines, each preceded by the
+// description of the attribute when withDescriptions is set. Optional
+// attributes are commented out. Like `terraform fmt`, consecutive lines are
+// aligned.
+function exampleAttributeLines(
+  attributes: Array<Attribute>,
+  indent: string,
+  withDescriptions: boolean,
+) {
+  const width = withDescriptions
+    ? 0
+    : Math.max(...attributes.map((attribute) => attribute.name.length));
+  return attributes.flatMap((attribute, index) => {
+    const lines: Array<string> = [];
+    if (withDescriptions) {
+      if (index > 0) {
+        lines.push("");
+      }
+      const optional = attribute.computedOptionalRequired !== "required";
+      lines.push(
+        `${indent}# ${optional ? "Optional. " : ""}${attribute.description}`,
+      );
+    }
+    const line = `${attribute.name.padEnd(width)} = ${exampleValue(attribute, indent)}`;
+    if (withDescriptions && attribute.computedOptionalRequired !== "required") {
+      // Multi-line values would need every line commented out.
+      if (!line.includes("\n")) {
+        lines.push(`${indent}# ${line}`);
+      }
+    } else {
+      lines.push(`${indent}${line}`);
+    }
+    return lines;
+  });
+}
+
+export function generateExample({ resource }: { resource: Resource }) {
+  const lines = exampleAttributeLines(
+    resource.attributes.filter(isConfigurable),
+    "  ",
+    true,
+  );
+  return `${HCL_SCAFFOLD_MARKER}
+resource "openai_${resource.name}" "example" {
+${lines.join("\n")}
+}
+`;
+}
+
+export function generateImportExample({ resource }: { resource: Resource }) {
+  const id = (resource.importStateAttributes ?? [])
+    .map((attribute) => `<${attribute === "id" ? `${resource.name}_id` : attribute}>`)
+    .join("/");
+  return `${HCL_SCAFFOLD_MARKER}
+# Import an existing ${resource.name.replaceAll("_", " ")}
+terraform import openai_${resource.name}.example ${id}
+`;
+}
+
+// testValue returns the Go expression of the value of a configured attribute
+// in the acceptance test, and the state check of that value.
+function testValue(attribute: Attribute): { config: string; check?: string } {
+  if (attribute.name === "project_id") {
+    return { config: "openai_project.test.id" };
+  }
+  return match(attribute)
+    .with({ type: "string" }, (attribute) => {
+      const [value] = enumValues(attribute);
+      if (value !== undefined) {
+        return {
+          config: JSON.stringify(value),
+          check: `knownvalue.StringExact(${JSON.stringify(value)})`,
+        };
+      }
+      if (attribute.name === "name") {
+        return {
+          config: "%[1]q",
+          check: "knownvalue.StringExact(name)",
+        };
+      }
+      // Values such as the IDs of other objects need a fixture.
+      return { config: `"TODO" # TODO: set a valid value` };
+    })
+    .with({ type: "int64" }, () => ({
+      config: "1",
+      check: "knownvalue.Int64Exact(1)",
+    }))
+    .with({ type: "bool" }, () => ({
+      config: "true",
+      check: "knownvalue.Bool(true)",
+    }))
+    .otherwise(() => ({
+      config: `null # TODO: set a valid value`,
+    }));
+}
+
+export function generateAccTest({ resource }: { resource: Resource }) {
+  const name = camelize(resource.name);
+  const rn = `openai_${resource.name}.test`;
+  // The test configures the required attributes and the name, if any.
+  const configured = resource.attributes.filter(
+    (attribute) =>
+      attribute.computedOptionalRequired === "required" ||
+      (isConfigurable(attribute) && attribute.name === "name"),
+  );
+  const usesName = configured.some((attribute) => attribute.name === "name");
+  const usesProject = configured.some(
+    (attribute) => attribute.name === "project_id",
+  );
+
+  const checks = resource.attributes.flatMap((attribute) => {
+    const path = `tfjsonpath.New(${JSON.stringify(attribute.name)})`;
+    if (!isConfigurable(attribute)) {
+      return attribute.nullable
+        ? [`// statecheck.ExpectKnownValue(rn, ${path}, knownvalue.Null()),`]
+        : [`statecheck.ExpectKnownValue(rn, ${path}, knownvalue.NotNull()),`];
+    }
+    if (!configured.includes(attribute)) {
+      return [];
+    }
+    const { check } = testValue(attribute);
+    return check
+      ? [`statecheck.ExpectKnownValue(rn, ${path}, ${check}),`]
+      : [];
+  });
+
+  const width = Math.max(...configured.map((attribute) => attribute.name.length));
+  const configLines = configured.map(
+    (attribute) =>
+      `\t${attribute.name.padEnd(width)} = ${testValue(attribute).config}`,
+  );
+
+  const importStep = resource.importStateAttributes
+    ? resource.importStateAttributes.length === 1
+      ? `{
+          ResourceName:      rn,
+          ImportState:       true,
+          ImportStateVerify: true,
+        },`
+      : `{
+          ResourceName: rn,
+          ImportState:  true,
+          ImportStateIdFunc: func(s *terraform.State) (string, error) {
+            rs, ok := s.RootModule().Resources[rn]
+            if !ok {
+              return "", fmt.Errorf("not found: %s", rn)
+            }
+            return tfutils.NewCompositeID(${resource.importStateAttributes
+              .map((attribute) => JSON.stringify(attribute))
+              .join(", ")}).Build(${resource.importStateAttributes
+              .map((attribute) => `rs.Primary.Attributes[${JSON.stringify(attribute)}]`)
+              .join(", ")}), nil
+          },
+          ImportStateVerify: true,
+        },`
+    : "";
+
+  return `${GO_SCAFFOLD_MARKER}
+
+package provider_test
+
+import (
+  "fmt"
+  "testing"
+
+  sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+  "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+  "github.com/hashicorp/terraform-plugin-testing/knownvalue"
+  "github.com/hashicorp/terraform-plugin-testing/statecheck"
+  "github.com/hashicorp/terraform-plugin-testing/terraform"
+  "github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+  "github.com/jianyuan/terraform-provider-openai/internal/acctest"
+  "github.com/jianyuan/terraform-provider-openai/internal/tfutils"
+)
+
+func TestAcc${name}Resource(t *testing.T) {
+  rn := "${rn}"
+  ${usesName ? `name := sdkacctest.RandomWithPrefix("tf-${resource.name.replaceAll("_", "-")}")` : ""}
+
+  resource.Test(t, resource.TestCase{
+    PreCheck:                 func() { acctest.PreCheck(t) },
+    ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+    Steps: []resource.TestStep{
+      {
+        Config: testAcc${name}ResourceConfig(${usesName ? "name" : ""}),
+        ConfigStateChecks: []statecheck.StateCheck{
+          ${checks.join("\n")}
+        },
+      },
+      ${importStep}
+    },
+  })
+}
+
+func testAcc${name}ResourceConfig(${usesName ? "name string" : ""}) string {
+  return ${usesName ? "fmt.Sprintf(" : ""}\`${
+    usesProject
+      ? `
+resource "openai_project" "test" {
+	name = ${usesName ? "%[1]q" : `"tf-${resource.name.replaceAll("_", "-")}"`}
+}
+`
+      : ""
+  }
+resource "openai_${resource.name}" "test" {
+${configLines.join("\n")}
+}
+\`${usesName ? ", name)" : ""}
+}
+`;
+}
+
+// generateSweeper returns the sweeper of a resource, which lists the objects
+// of the resource type, within every project for project scoped resources,
+// and deletes those whose name matches.
+export function generateSweeper({ resource }: { resource: Resource }) {
+  const { sweeper, api } = resource;
+  if (!sweeper) {
+    throw new Error(`Resource ${resource.name} has no sweeper`);
+  }
+
+  const parents = api.createRequestAttributes ?? [];
+  const deleteAttributes = api.deleteRequestAttributes ?? [];
+  if (
+    !(parents.length === 0 || (parents.length === 1 && parents[0] === "project_id")) ||
+    deleteAttributes.join("/") !== [...parents, "id"].join("/")
+  ) {
+    throw new Error(
+      `Resource ${resource.name}: generated sweepers only support organization and project scoped resources deleted by id`,
+    );
+  }
+
+  const functionName = pluralize(camelize(resource.name, true));
+  const projectScoped = parents.length === 1;
+  const matchField = sweeper.matchField ?? "Name";
+  const hasCreatedAt = resource.attributes.some(
+    (attribute) => attribute.name === "created_at",
+  );
+  const attributes = [
+    ...(projectScoped ? [`"project_id": projectId,`] : []),
+    `"id": item.ID,`,
+  ];
+
+  const list = `
+    params := openai.${sweeper.listParamsStruct}{
+      Limit: openai.Int(100),
+    }
+
+    iter := client.${api.method}.ListAutoPaging(ctx, ${projectScoped ? "projectId, " : ""}params)
+    for iter.Next() {
+      item := iter.Current()
+      if match(item.${matchField}) {
+        sweepables = append(sweepables, sweep.NewSweepResource(provider.New${camelize(resource.name)}Resource, client, map[string]any{
+          ${attributes.join("\n")}
+        })${hasCreatedAt ? ".WithCreatedAt(item.CreatedAt)" : ""})
+      }
+    }
+  `;
+
+  const body = projectScoped
+    ? `
+    var sweepables []sweep.Sweepable
+
+    projectIds, err := projectIds(ctx, client)
+    if err != nil {
+      return nil, err
+    }
+
+    for _, projectId := range projectIds {
+      log.Printf("[INFO] Listing ${pluralize(resource.name.replaceAll("_", " "))} for project %s", projectId)
+
+      ${list}
+      if err := iter.Err(); err != nil {
+        return nil, err
+      }
+    }
+
+    return sweepables, nil
+    `
+    : `
+    var sweepables []sweep.Sweepable
+
+    ${list}
+
+    return sweepables, iter.Err()
+    `;
+
+  return `${GO_SCAFFOLD_MARKER}
+
+package sweepers
+
+import (
+  "github.com/jianyuan/terraform-provider-openai/internal/provider"
+  "github.com/jianyuan/terraform-provider-openai/internal/sweep"
+  "github.com/openai/openai-go/v3"
+)
+
+func ${functionName}(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+  ${body}
+}
+`;
+}
+
+// generateSweepers returns the list of the generated sweepers, which All
+// returns along with the hand-written ones. Unlike the sweepers themselves,
+// the list is regenerated even once a sweeper is taken over by hand.
+export function generateSweepers({
+  resources,
+}: {
+  resources: Array<Resource>;
+}) {
+  const sweepers = resources
+    .filter((resource) => resource.sweeper)
+    .sort((a, b) => a.name.localeCompare(b.name))
+    .map((resource) => {
+      const dependencies = resource.sweeper?.dependencies ?? [];
+      const fields = [
+        `Name: "openai_${resource.name}",`,
+        ...(dependencies.length
+          ? [
+              `Dependencies: []string{${dependencies.map((dependency) => JSON.stringify(dependency)).join(", ")}},`,
+            ]
+          : []),
+        `F: ${pluralize(camelize(resource.name, true))},`,
+      ];
+      return `{
+        ${fields.join("\n")}
+      },`;
+    });
+
+  return `// Code generated by providergen. DO NOT EDIT.
+package sweepers
+
+import (
+  "github.com/jianyuan/terraform-provider-openai/internal/sweep"
+)
+
+// generatedSweepers returns the sweepers of the resources with a sweeper in
+// internal/providergen/settings.ts.
+func generatedSweepers() []sweep.Sweeper {
+  return []sweep.Sweeper{
+    ${sweepers.join("\n")}
+  }
+}
+`;
+}
@@ -14,9 +14,13 @@ export type Attribute =
   | SetAttribute
   | SetNestedAttribute
   | MapAttribute
+  | MapNestedAttribute
   | ObjectAttribute
   | SingleNestedAttribute;
 
+// ElementType is the type of the elements of list, set and map attributes.
+export type ElementType = "string" | "int64" | "float64" | "bool";
+
 export interface BaseAttribute {
   name: string;
   customType?: {
@@ -36,6 +40,20 @@ export interface BaseAttribute {
   };
 }
 
+// AttributeOverride is an attribute as written in settings.ts. Attributes of
+// resources bound to the OpenAPI spec only need the fields that differ from
+// those derived from the spec; the others must be complete.
+export interface AttributeOverride
+  extends Partial<Omit<BaseAttribute, "name" | "filler">> {
+  name: string;
+  type?: Attribute["type"];
+  elementType?: ElementType;
+  attributes?: Array<AttributeOverride>;
+  filler?: BaseAttribute["filler"] & {
+    model?: string;
+  };
+}
+
 export interface StringAttribute extends BaseAttribute {
   type: "string";
 }
@@ -54,7 +72,7 @@ export interface BoolAttribute extends BaseAttribute {
 
 export interface ListAttribute extends BaseAttribute {
   type: "list";
-  elementType: "string";
+  elementType: ElementType;
 }
 
 export interface ListNestedAttribute extends BaseAttribute {
@@ -67,7 +85,7 @@ export interface ListNestedAttribute extends BaseAttribute {
 
 export interface SetAttribute extends BaseAttribute {
   type: "set";
-  elementType: "string";
+  elementType: ElementType;
 }
 
 export interface SetNestedAttribute extends BaseAttribute {
@@ -80,11 +98,13 @@ export interface SetNestedAttribute extends BaseAttribute {
 
 export interface MapAttribute extends BaseAttribute {
   type: "map";
-  elementType: "string";
+  elementType: ElementType;
 }
 
-export interface SingleNestedAttribute extends BaseAttribute {
-  type: "single_nested";
+// MapNestedAttribute is a map of objects keyed by a string, e.g. the rate
+// limits of a project keyed by model.
+export interface MapNestedAttribute extends BaseAttribute {
+  type: "map_nested";
   attributes: Array<Attribute>;
   filler?: BaseAttribute["filler"] & {
     model: string;
@@ -147,10 +167,66 @@ export interface BaseResourceApiStrategy {
   updateRequestAttributes?: Array<string>;
   deleteMethod?: string;
   deleteRequestAttributes?: Array<string>;
+  // deleteStrategy defaults to api_delete when deleteMethod is set, and must
+  // be set otherwise.
+  deleteStrategy?: DeleteStrategy;
+  // createParams and updateParams generate the getNewParams and
+  // getUpdateParams methods. Without them, the methods are hand-written in
+  // resource_<name>_model.go. createParams is "updateParams" when creating
+  // sends the update params, e.g. for settings.
+  createParams?: ResourceParams | "updateParams";
+  updateParams?: ResourceParams;
+}
+
+// ResourceParams maps attributes to the fields of a params struct of the SDK.
+// Attributes that are not listed are not sent.
+export interface ResourceParams {
+  // struct is the params struct, e.g. "AdminOrganizationProjectNewParams".
+  struct: string;
+  fields: Array<ParamsField>;
 }
 
+export interface ParamsField {
+  // attribute is the name of the attribute holding the value.
+  attribute: string;
+  // field is the field of the struct. It defaults to the camelized attribute
+  // name.
+  field?: string;
+  // opt is set when the field is a param.Opt. Null values are omitted, unless
+  // opt is "zero", which sends the zero value so that removing the attribute
+  // from the configuration clears the field.
+  opt?: true | "zero";
+  // enum lists the SDK constants the value must be one of, e.g.
+  // ["AdminOrganizationInviteNewParamsRoleReader"]. The field is of their
+  // type.
+  enum?: Array<string>;
+  // struct and fields map the attributes of a nested attribute to the params
+  // struct of its objects.
+  struct?: string;
+  fields?: Array<ParamsField>;
+}
+
+// DeleteStrategy is what destroying a resource does to the API:
+//
+//   - api_delete calls deleteMethod;
+//   - reset_to_defaults calls updateMethod with the params returned by the
+//     hand-written getResetParams method. Create stores the object read
+//     before the resource was created in the private state, and
+//     getResetParams restores it, or returns documented defaults when it is
+//     unknown, e.g. after an import. When it returns nil, the settings are
+//     left as they are with a warning;
+//   - remove_from_state only removes the resource from the state, for
+//     settings that cannot be reset.
+export type DeleteStrategy =
+  | "api_delete"
+  | "reset_to_defaults"
+  | "remove_from_state";
+
 export interface SimpleResourceApiStrategy extends BaseResourceApiStrategy {
   readStrategy?: never;
+  // readModel is the type returned by readMethod. reset_to_defaults needs it
+  // when the resource has no filler.
+  readModel?: string;
 }
 
 export interface PaginateResourceApiStrategy extends BaseResourceApiStrategy {
@@ -167,8 +243,71 @@ export interface Resource {
   description: string;
   api: ResourceApiStrategy;
   importStateAttributes?: Array<string>;
+  // importStateAlternateSeparators are the separators accepted by the import
+  // ID besides "/", e.g. ":" for project_id:user_id.
+  importStateAlternateSeparators?: Array<string>;
   filler?: {
     model: string;
   };
+  sweeper?: ResourceSweeper;
+  mock: ResourceMock;
   attributes: Array<Attribute>;
 }
+
+// ResourceSweeper configures the generated sweeper of a resource.
+export interface ResourceSweeper {
+  // listParamsStruct is the params struct of the ListAutoPaging method of
+  // api.method.
+  listParamsStruct: string;
+  // matchField is the field of the listed objects that is matched against the
+  // name prefixes. It defaults to Name.
+  matchField?: string;
+  // dependencies are the resource types swept first.
+  dependencies?: Array<string>;
+}
+
+// ResourceMock describes how the mock server in internal/mockserver serves a
+// resource. Every resource needs one, so that its acceptance test can run
+// against the mock server.
+export type ResourceMock = GeneratedResourceMock | HandWrittenResourceMock;
+
+// GeneratedResourceMock generates a table and the CRUD and list routes of a
+// resource from its attributes. It fits endpoints that store the request body
+// and return it with an ID. The path parameters of `path` must be the
+// readRequestAttributes of the resource, without the trailing "id".
+export interface GeneratedResourceMock {
+  // path is the collection path, e.g.
+  // "/organization/projects/:project_id/spend_alerts".
+  path: string;
+  // object is the `object` field of the API object.
+  object: string;
+  idPrefix: string;
+  pagination?: PaginationStyle;
+}
+
+// HandWrittenResourceMock is served by internal/mockserver/routes/<route>.ts,
+// for endpoints that do more than store objects, such as singletons, upserts
+// and role assignments.
+export interface HandWrittenResourceMock {
+  route: string;
+}
+
+// PaginationStyle is the cursor style of a list endpoint, see
+// internal/mockserver/pagination.ts.
+export type PaginationStyle = "last_id" | "next";
+
+// OpenApiBinding names the component schemas in openapi/admin.json that the
+// attributes of a resource are derived from.
+export interface OpenApiBinding {
+  // schema is the API object returned by the read method.
+  schema: string;
+  createRequest?: string;
+  updateRequest?: string;
+  // exclude lists the properties that are not exposed as attributes.
+  exclude?: Array<string>;
+}
+
+export interface ResourceSettings extends Omit<Resource, "attributes"> {
+  openapi?: OpenApiBinding;
+  attributes: Array<AttributeOverride>;
+}
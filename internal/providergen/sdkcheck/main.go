@@ -0,0 +1,339 @@
+// Command sdkcheck checks that the openai-go identifiers referenced by
+// settings.ts exist, so that mistakes are reported before providergen writes
+// any file rather than as build errors in the generated code.
+//
+// Usage:
+//
+//	sdkcheck [file]
+//
+// It checks the references printed by `bun run ./index.ts --references`, which
+// it runs in the working directory unless the references are read from file,
+// or from stdin if file is "-".
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/constant"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+)
+
+const (
+	sdkPath   = "github.com/openai/openai-go/v3"
+	paramPath = sdkPath + "/packages/param"
+)
+
+// Reference mirrors the Reference type of references.ts.
+type Reference struct {
+	Context       string   `json:"context"`
+	Kind          string   `json:"kind"`
+	Type          string   `json:"type"`
+	Path          []string `json:"path"`
+	Want          string   `json:"want"`
+	ConvertibleTo string   `json:"convertibleTo"`
+	Name          string   `json:"name"`
+	Values        []string `json:"values"`
+}
+
+func main() {
+	log.SetFlags(0)
+
+	refs, err := readReferences(os.Args[1:])
+	if err != nil {
+		log.Fatalf("failed to read references: %s", err)
+	}
+
+	pkg, err := loadPackage(sdkPath)
+	if err != nil {
+		log.Fatalf("failed to load %s: %s", sdkPath, err)
+	}
+
+	errs := check(pkg, refs)
+	for _, err := range errs {
+		log.Print(err)
+	}
+	if len(errs) > 0 {
+		log.Fatalf("settings.ts has %d invalid references to %s", len(errs), sdkPath)
+	}
+}
+
+// readReferences reads the references from the file in args, or runs
+// providergen to print them without one.
+func readReferences(args []string) ([]Reference, error) {
+	var data []byte
+	var err error
+	switch {
+	case len(args) == 0:
+		cmd := exec.Command("bun", "run", "./index.ts", "--references")
+		cmd.Stderr = os.Stderr
+		data, err = cmd.Output()
+	case args[0] == "-":
+		data, err = io.ReadAll(os.Stdin)
+	default:
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []Reference
+	if err := json.Unmarshal(data, &refs); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// loadPackage loads the type information of a package from the export data
+// built by the go command, so that the version in go.mod is used.
+func loadPackage(path string) (*types.Package, error) {
+	out, err := exec.Command("go", "list", "-export", "-deps", "-f", "{{.ImportPath}}={{.Export}}", path).Output()
+	if err != nil {
+		if exitErr, ok := errors.AsType[*exec.ExitError](err); ok {
+			return nil, fmt.Errorf("go list: %s", exitErr.Stderr)
+		}
+		return nil, err
+	}
+
+	exports := make(map[string]string)
+	for line := range strings.Lines(string(out)) {
+		importPath, export, _ := strings.Cut(strings.TrimSpace(line), "=")
+		exports[importPath] = export
+	}
+
+	imp := importer.ForCompiler(token.NewFileSet(), "gc", func(path string) (io.ReadCloser, error) {
+		export := exports[path]
+		if export == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(export)
+	})
+	return imp.Import(path)
+}
+
+func check(pkg *types.Package, refs []Reference) []error {
+	var errs []error
+	for _, ref := range refs {
+		if err := checkReference(pkg, ref); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ref.Context, err))
+		}
+	}
+	return errs
+}
+
+func checkReference(pkg *types.Package, ref Reference) error {
+	switch ref.Kind {
+	case "method":
+		return checkMethod(pkg, ref.Path)
+	case "type":
+		_, err := lookupType(pkg, ref.Type)
+		return err
+	case "field":
+		return checkField(pkg, ref)
+	case "constant":
+		return checkConstant(pkg, ref)
+	default:
+		return fmt.Errorf("unknown reference kind %q", ref.Kind)
+	}
+}
+
+// checkMethod checks that path selects a method from *openai.Client, e.g.
+// client.Admin.Organization.Projects.New.
+func checkMethod(pkg *types.Package, path []string) error {
+	if len(path) == 0 {
+		return errors.New("no method")
+	}
+
+	typ, err := lookupType(pkg, "*"+pkg.Name()+".Client")
+	if err != nil {
+		return err
+	}
+
+	selector := pkg.Name() + ".Client"
+	for i, name := range path {
+		obj, _, _ := types.LookupFieldOrMethod(typ, true, pkg, name)
+		last := i == len(path)-1
+		switch obj := obj.(type) {
+		case *types.Var:
+			if last {
+				return fmt.Errorf("%s.%s is a field, not a method", selector, name)
+			}
+			typ = obj.Type()
+		case *types.Func:
+			if !last {
+				return fmt.Errorf("%s.%s is a method, not a field", selector, name)
+			}
+		default:
+			return fmt.Errorf("%s has no field or method %s", selector, name)
+		}
+		selector += "." + name
+	}
+	return nil
+}
+
+// checkField checks that the field path exists in ref.Type and that the field
+// has the wanted type.
+func checkField(pkg *types.Package, ref Reference) error {
+	typ, selector, err := lookupField(pkg, ref)
+	if err != nil {
+		return err
+	}
+
+	if ref.Want != "" {
+		want, err := lookupType(pkg, ref.Want)
+		if err != nil {
+			return err
+		}
+		if !types.Identical(typ, want) {
+			return fmt.Errorf("%s is of type %s, want %s", selector, typeString(typ), ref.Want)
+		}
+	}
+
+	if ref.ConvertibleTo != "" {
+		basic, ok := types.Universe.Lookup(ref.ConvertibleTo).(*types.TypeName)
+		if !ok {
+			return fmt.Errorf("unknown basic type %q", ref.ConvertibleTo)
+		}
+		// Integers convert to strings as runes, which is never what is meant.
+		isInteger := func(typ types.Type) bool {
+			basic, ok := typ.Underlying().(*types.Basic)
+			return ok && basic.Info()&types.IsInteger != 0
+		}
+		if !types.ConvertibleTo(typ, basic.Type()) || ref.ConvertibleTo == "string" && isInteger(typ) {
+			return fmt.Errorf("%s is of type %s, which cannot be converted to %s", selector, typeString(typ), ref.ConvertibleTo)
+		}
+	}
+
+	return nil
+}
+
+// checkConstant checks that ref.Name is a constant of the type of the field
+// path in ref.Type, e.g. an enum value of a params field.
+func checkConstant(pkg *types.Package, ref Reference) error {
+	typ, selector, err := lookupField(pkg, ref)
+	if err != nil {
+		return err
+	}
+
+	name, ok := strings.CutPrefix(ref.Name, pkg.Name()+".")
+	if !ok {
+		return fmt.Errorf("unsupported constant %q, want a constant of package %s", ref.Name, pkg.Name())
+	}
+	obj, ok := pkg.Scope().Lookup(name).(*types.Const)
+	if !ok {
+		return fmt.Errorf("undefined constant %s", ref.Name)
+	}
+	if !types.Identical(obj.Type(), typ) {
+		return fmt.Errorf("%s is of type %s, but %s is of type %s", ref.Name, typeString(obj.Type()), selector, typeString(typ))
+	}
+	if obj.Val().Kind() != constant.String {
+		return fmt.Errorf("%s is not a string constant", ref.Name)
+	}
+	if value := constant.StringVal(obj.Val()); !slices.Contains(ref.Values, value) {
+		return fmt.Errorf("%s is %q, want one of %q", ref.Name, value, ref.Values)
+	}
+	return nil
+}
+
+// lookupField resolves the field path of ref in ref.Type, returning the type
+// of the field and its selector.
+func lookupField(pkg *types.Package, ref Reference) (types.Type, string, error) {
+	typ, err := lookupType(pkg, ref.Type)
+	if err != nil {
+		return nil, "", err
+	}
+
+	selector := ref.Type
+	for _, name := range ref.Path {
+		obj, _, _ := types.LookupFieldOrMethod(typ, true, pkg, name)
+		field, ok := obj.(*types.Var)
+		if !ok || !field.IsField() {
+			return nil, "", fmt.Errorf("%s has no field %s", selector, name)
+		}
+		typ = field.Type()
+		selector += "." + name
+	}
+	return typ, selector, nil
+}
+
+// lookupType resolves a type expression such as "openai.Project",
+// "*openai.Project", "[]openai.Project" or "map[string]openai.Project", as
+// well as basic types and the "param.Opt[string]" fields of params structs.
+func lookupType(pkg *types.Package, expr string) (types.Type, error) {
+	if basic, ok := types.Universe.Lookup(expr).(*types.TypeName); ok {
+		return basic.Type(), nil
+	}
+	if elem, ok := strings.CutPrefix(expr, "param.Opt["); ok {
+		elem, ok := strings.CutSuffix(elem, "]")
+		if !ok {
+			return nil, fmt.Errorf("unsupported type %q", expr)
+		}
+		return lookupOpt(pkg, elem)
+	}
+	if elem, ok := strings.CutPrefix(expr, "map[string]"); ok {
+		typ, err := lookupType(pkg, elem)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewMap(types.Typ[types.String], typ), nil
+	}
+	if elem, ok := strings.CutPrefix(expr, "[]"); ok {
+		typ, err := lookupType(pkg, elem)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewSlice(typ), nil
+	}
+	if elem, ok := strings.CutPrefix(expr, "*"); ok {
+		typ, err := lookupType(pkg, elem)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewPointer(typ), nil
+	}
+
+	name, ok := strings.CutPrefix(expr, pkg.Name()+".")
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %q, want a type of package %s", expr, pkg.Name())
+	}
+	obj, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("undefined type %s", expr)
+	}
+	return obj.Type(), nil
+}
+
+// lookupOpt instantiates param.Opt, the optional fields of params structs,
+// with the type elem.
+func lookupOpt(pkg *types.Package, elem string) (types.Type, error) {
+	typ, err := lookupType(pkg, elem)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, imported := range pkg.Imports() {
+		if imported.Path() != paramPath {
+			continue
+		}
+		opt, ok := imported.Scope().Lookup("Opt").(*types.TypeName)
+		if !ok {
+			break
+		}
+		return types.Instantiate(nil, opt.Type(), []types.Type{typ}, true)
+	}
+	return nil, fmt.Errorf("%s.Opt not found", paramPath)
+}
+
+func typeString(typ types.Type) string {
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		return pkg.Name()
+	})
+}
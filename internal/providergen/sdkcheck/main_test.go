@@ -0,0 +1,125 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	pkg, err := loadPackage(sdkPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		ref     Reference
+		wantErr string
+	}{
+		{
+			name: "method",
+			ref:  Reference{Kind: "method", Path: []string{"Admin", "Organization", "Projects", "New"}},
+		},
+		{
+			name:    "unknown method",
+			ref:     Reference{Kind: "method", Path: []string{"Admin", "Organization", "Projects", "Create"}},
+			wantErr: "openai.Client.Admin.Organization.Projects has no field or method Create",
+		},
+		{
+			name:    "field instead of method",
+			ref:     Reference{Kind: "method", Path: []string{"Admin", "Organization"}},
+			wantErr: "openai.Client.Admin.Organization is a field, not a method",
+		},
+		{
+			name: "type",
+			ref:  Reference{Kind: "type", Type: "[]openai.Project"},
+		},
+		{
+			name:    "unknown type",
+			ref:     Reference{Kind: "type", Type: "openai.Projects"},
+			wantErr: "undefined type openai.Projects",
+		},
+		{
+			name: "field",
+			ref:  Reference{Kind: "field", Type: "openai.Project", Path: []string{"ExternalKeyID"}, ConvertibleTo: "string"},
+		},
+		{
+			name: "nullable field",
+			ref:  Reference{Kind: "field", Type: "openai.Project", Path: []string{"JSON", "ArchivedAt"}},
+		},
+		{
+			name:    "unknown field",
+			ref:     Reference{Kind: "field", Type: "openai.Project", Path: []string{"ExternalKeyId"}},
+			wantErr: "openai.Project has no field ExternalKeyId",
+		},
+		{
+			name:    "inconvertible field",
+			ref:     Reference{Kind: "field", Type: "openai.Project", Path: []string{"CreatedAt"}, ConvertibleTo: "string"},
+			wantErr: "openai.Project.CreatedAt is of type int64, which cannot be converted to string",
+		},
+		{
+			name: "nested field",
+			ref:  Reference{Kind: "field", Type: "openai.OrganizationSpendLimit", Path: []string{"Enforcement"}, Want: "openai.OrganizationSpendLimitEnforcement"},
+		},
+		{
+			name:    "mismatched nested field",
+			ref:     Reference{Kind: "field", Type: "openai.OrganizationSpendLimit", Path: []string{"Enforcement"}, Want: "openai.ProjectSpendLimitEnforcement"},
+			wantErr: "openai.OrganizationSpendLimit.Enforcement is of type openai.OrganizationSpendLimitEnforcement, want openai.ProjectSpendLimitEnforcement",
+		},
+		{
+			name: "slice itself",
+			ref:  Reference{Kind: "field", Type: "[]openai.Group", Want: "[]openai.Group"},
+		},
+		{
+			name: "plain params field",
+			ref:  Reference{Kind: "field", Type: "openai.AdminOrganizationProjectNewParams", Path: []string{"Name"}, Want: "string"},
+		},
+		{
+			name: "optional params field",
+			ref:  Reference{Kind: "field", Type: "openai.AdminOrganizationProjectNewParams", Path: []string{"ExternalKeyID"}, Want: "param.Opt[string]"},
+		},
+		{
+			name:    "mismatched optional params field",
+			ref:     Reference{Kind: "field", Type: "openai.AdminOrganizationProjectNewParams", Path: []string{"Name"}, Want: "param.Opt[string]"},
+			wantErr: "openai.AdminOrganizationProjectNewParams.Name is of type string, want param.Opt[string]",
+		},
+		{
+			name: "constant",
+			ref:  Reference{Kind: "constant", Name: "openai.AdminOrganizationInviteNewParamsRoleOwner", Type: "openai.AdminOrganizationInviteNewParams", Path: []string{"Role"}, Values: []string{"owner", "reader"}},
+		},
+		{
+			name:    "constant missing from the values",
+			ref:     Reference{Kind: "constant", Name: "openai.AdminOrganizationInviteNewParamsRoleOwner", Type: "openai.AdminOrganizationInviteNewParams", Path: []string{"Role"}, Values: []string{"reader"}},
+			wantErr: `openai.AdminOrganizationInviteNewParamsRoleOwner is "owner", want one of ["reader"]`,
+		},
+		{
+			name:    "constant of another enum",
+			ref:     Reference{Kind: "constant", Name: "openai.AdminOrganizationSpendLimitUpdateParamsCurrencyUsd", Type: "openai.AdminOrganizationInviteNewParams", Path: []string{"Role"}},
+			wantErr: "openai.AdminOrganizationSpendLimitUpdateParamsCurrencyUsd is of type openai.AdminOrganizationSpendLimitUpdateParamsCurrency, but openai.AdminOrganizationInviteNewParams.Role is of type openai.AdminOrganizationInviteNewParamsRole",
+		},
+		{
+			name:    "unknown constant",
+			ref:     Reference{Kind: "constant", Name: "openai.AdminOrganizationInviteNewParamsRoleAdmin", Type: "openai.AdminOrganizationInviteNewParams", Path: []string{"Role"}},
+			wantErr: "undefined constant openai.AdminOrganizationInviteNewParamsRoleAdmin",
+		},
+		{
+			name: "map itself",
+			ref:  Reference{Kind: "field", Type: "map[string]openai.Group", Want: "map[string]openai.Group"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.ref.Context = "resource test"
+
+			errs := check(pkg, []Reference{tc.ref})
+
+			switch {
+			case tc.wantErr == "" && len(errs) != 0:
+				t.Errorf("got errors %v, want none", errs)
+			case tc.wantErr != "" && len(errs) != 1:
+				t.Errorf("got errors %v, want one", errs)
+			case tc.wantErr != "" && errs[0].Error() != "resource test: "+tc.wantErr:
+				t.Errorf("got error %q, want %q", errs[0], "resource test: "+tc.wantErr)
+			}
+		})
+	}
+}
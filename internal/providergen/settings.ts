@@ -1,5 +1,7 @@
-import type { DataSource, Resource } from "./schema";
+import type { DataSource, ResourceSettings } from "./schema";
 
+// Data sources are not bound to the OpenAPI spec yet, so they list every
+// attribute in full.
 export const DATASOURCES: Array<DataSource> = [
   {
     name: "groups",
@@ -1256,7 +1258,15 @@ export const DATASOURCES: Array<DataSource> = [
   },
 ];
 
-export const RESOURCES: Array<Resource> = [
+// HANDWRITTEN_DATASOURCES are the data sources in
+// internal/provider/data_source_<name>.go that are not generated, e.g. because
+// they are not backed by a single API method. They are registered with the
+// provider next to the generated ones.
+export const HANDWRITTEN_DATASOURCES: Array<string> = ["permissions"];
+
+// Only the resources with an `openapi` binding derive their attributes from
+// openapi/admin.json. The others still list every attribute in full.
+export const RESOURCES: Array<ResourceSettings> = [
   {
     name: "admin_api_key",
     description: "Manages an organization admin API key.",
@@ -1267,7 +1277,14 @@ export const RESOURCES: Array<Resource> = [
       readRequestAttributes: ["id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["id"],
+      createParams: {
+        struct: "AdminOrganizationAdminAPIKeyNewParams",
+        fields: [
+          { attribute: "name" },
+        ],
+      },
     },
+    mock: { route: "admin-api-keys" },
     attributes: [
       {
         name: "name",
@@ -1313,17 +1330,34 @@ export const RESOURCES: Array<Resource> = [
       readRequestAttributes: ["id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["id"],
+      createParams: {
+        struct: "AdminOrganizationInviteNewParams",
+        fields: [
+          { attribute: "email" },
+          {
+            attribute: "role",
+            enum: [
+              "AdminOrganizationInviteNewParamsRoleReader",
+              "AdminOrganizationInviteNewParamsRoleOwner",
+            ],
+          },
+        ],
+      },
     },
     importStateAttributes: ["id"],
+    openapi: {
+      schema: "Invite",
+      createRequest: "InviteRequest",
+      exclude: ["projects"],
+    },
     filler: {
       model: "openai.Invite",
     },
+    mock: { route: "invites" },
     attributes: [
       {
         name: "id",
-        type: "string",
         description: "Invite ID.",
-        computedOptionalRequired: "computed",
         planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
         filler: {
           sourceAttribute: ["ID"],
@@ -1331,48 +1365,17 @@ export const RESOURCES: Array<Resource> = [
       },
       {
         name: "email",
-        type: "string",
-        description:
-          "The email address of the individual to whom the invite was sent.",
-        computedOptionalRequired: "required",
         planModifiers: ["stringplanmodifier.RequiresReplace()"],
       },
       {
         name: "role",
-        type: "string",
-        description: "`owner` or `reader`.",
-        computedOptionalRequired: "required",
+        description:
+          "`owner` or `reader`. Roles that are not predefined roles of the organization fail the plan.",
         planModifiers: ["stringplanmodifier.RequiresReplace()"],
-        validators: ['stringvalidator.OneOf("owner", "reader")'],
       },
       {
         name: "status",
-        type: "string",
         description: "`accepted`, `expired`, or `pending`.",
-        computedOptionalRequired: "computed",
-      },
-      {
-        name: "created_at",
-        type: "int64",
-        description:
-          "The Unix timestamp (in seconds) of when the invite was sent.",
-        computedOptionalRequired: "computed",
-      },
-      {
-        name: "expires_at",
-        type: "int64",
-        description:
-          "The Unix timestamp (in seconds) of when the invite expires.",
-        computedOptionalRequired: "computed",
-        nullable: true,
-      },
-      {
-        name: "accepted_at",
-        type: "int64",
-        description:
-          "The Unix timestamp (in seconds) of when the invite was accepted.",
-        computedOptionalRequired: "computed",
-        nullable: true,
       },
     ],
   },
@@ -1388,11 +1391,28 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["id"],
+      createParams: {
+        struct: "AdminOrganizationRoleNewParams",
+        fields: [
+          { attribute: "name", field: "RoleName" },
+          { attribute: "permissions" },
+          { attribute: "description", opt: "zero" },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationRoleUpdateParams",
+        fields: [
+          { attribute: "name", field: "RoleName", opt: "zero" },
+          { attribute: "permissions" },
+          { attribute: "description", opt: "zero" },
+        ],
+      },
     },
     importStateAttributes: ["id"],
     filler: {
       model: "openai.Role",
     },
+    mock: { route: "roles" },
     attributes: [
       {
         name: "id",
@@ -1419,7 +1439,8 @@ export const RESOURCES: Array<Resource> = [
       {
         name: "permissions",
         type: "set",
-        description: "Permissions to grant to the role.",
+        description:
+          "Permissions to grant to the role, as listed by the `openai_permissions` data source. Permissions that no predefined organization role grants fail the plan.",
         computedOptionalRequired: "required",
         elementType: "string",
       },
@@ -1437,17 +1458,36 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["id"],
       deleteMethod: "Archive",
       deleteRequestAttributes: ["id"],
+      createParams: {
+        struct: "AdminOrganizationProjectNewParams",
+        fields: [
+          { attribute: "name" },
+          { attribute: "external_key_id", field: "ExternalKeyID", opt: true },
+          { attribute: "geography", opt: true },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationProjectUpdateParams",
+        fields: [
+          { attribute: "name", opt: "zero" },
+          { attribute: "external_key_id", field: "ExternalKeyID", opt: true },
+        ],
+      },
     },
     importStateAttributes: ["id"],
+    openapi: {
+      schema: "Project",
+      createRequest: "ProjectCreateRequest",
+      updateRequest: "ProjectUpdateRequest",
+    },
     filler: {
       model: "openai.Project",
     },
+    mock: { route: "projects" },
     attributes: [
       {
         name: "id",
-        type: "string",
         description: "The ID of the project.",
-        computedOptionalRequired: "computed",
         planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
         filler: {
           sourceAttribute: ["ID"],
@@ -1455,56 +1495,33 @@ export const RESOURCES: Array<Resource> = [
       },
       {
         name: "name",
-        type: "string",
         description:
           "The friendly name of the project, this name appears in reports.",
-        computedOptionalRequired: "required",
       },
       {
         name: "geography",
-        type: "string",
         description:
           "Create the project with the specified data residency region. Your organization must have access to Data residency functionality in order to use.",
-        computedOptionalRequired: "optional",
         planModifiers: ["stringplanmodifier.RequiresReplace()"],
+        // The spec does not enumerate the data residency regions.
         validators: [
           'stringvalidator.OneOf("US", "EU", "JP", "IN", "KR", "CA", "AU", "SG")',
         ],
-        filler: { skip: true },
       },
       {
         name: "status",
-        type: "string",
         description: "Status `active` or `archived`.",
-        computedOptionalRequired: "computed",
       },
       {
         name: "external_key_id",
-        type: "string",
         description:
           "The ID of the customer-managed encryption key to use for Enterprise Key Management (EKM). EKM is only available on certain accounts. Refer to the [EKM (External Keys) in the Management API Article](https://help.openai.com/en/articles/20000953-ekm-external-keys-in-the-management-api).",
-        computedOptionalRequired: "optional",
         planModifiers: ["stringplanmodifier.UseStateForUnknown()"],
         nullable: true,
         filler: {
           sourceAttribute: ["ExternalKeyID"],
         },
       },
-      {
-        name: "created_at",
-        type: "int64",
-        description:
-          "The Unix timestamp (in seconds) of when the project was created.",
-        computedOptionalRequired: "computed",
-      },
-      {
-        name: "archived_at",
-        type: "int64",
-        description:
-          "The Unix timestamp (in seconds) of when the project was archived or `null`.",
-        computedOptionalRequired: "computed",
-        nullable: true,
-      },
     ],
   },
   {
@@ -1518,8 +1535,15 @@ export const RESOURCES: Array<Resource> = [
       readRequestAttributes: ["project_id", "group_id", "role_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id", "group_id", "role_id"],
+      createParams: {
+        struct: "AdminOrganizationProjectGroupRoleNewParams",
+        fields: [
+          { attribute: "role_id", field: "RoleID" },
+        ],
+      },
     },
     importStateAttributes: ["project_id", "group_id", "role_id"],
+    mock: { route: "project-group-roles" },
     attributes: [
       {
         name: "project_id",
@@ -1555,8 +1579,15 @@ export const RESOURCES: Array<Resource> = [
       readRequestAttributes: ["project_id", "user_id", "role_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id", "user_id", "role_id"],
+      createParams: {
+        struct: "AdminOrganizationProjectUserRoleNewParams",
+        fields: [
+          { attribute: "role_id", field: "RoleID" },
+        ],
+      },
     },
     importStateAttributes: ["project_id", "user_id", "role_id"],
+    mock: { route: "project-user-roles" },
     attributes: [
       {
         name: "project_id",
@@ -1584,7 +1615,7 @@ export const RESOURCES: Array<Resource> = [
   {
     name: "project_rate_limit",
     description:
-      "Manage rate limits per model for projects. Rate limits may be configured to be equal to or lower than the organization's rate limits.",
+      "Manage rate limits per model for projects. Rate limits may be configured to be equal to or lower than the organization's rate limits. Destroying the resource restores the rate limits from before it was created, if known.",
     api: {
       method: "Admin.Organization.Projects.RateLimits",
       createMethod: "UpdateRateLimit",
@@ -1597,10 +1628,24 @@ export const RESOURCES: Array<Resource> = [
         "AdminOrganizationProjectRateLimitListRateLimitsParams",
       updateMethod: "UpdateRateLimit",
       updateRequestAttributes: ["project_id", "rate_limit_id"],
+      deleteStrategy: "reset_to_defaults",
+      createParams: "updateParams",
+      updateParams: {
+        struct: "AdminOrganizationProjectRateLimitUpdateRateLimitParams",
+        fields: [
+          { attribute: "batch_1_day_max_input_tokens", opt: true },
+          { attribute: "max_audio_megabytes_per_1_minute", opt: true },
+          { attribute: "max_images_per_1_minute", opt: true },
+          { attribute: "max_requests_per_1_day", opt: true },
+          { attribute: "max_requests_per_1_minute", opt: true },
+          { attribute: "max_tokens_per_1_minute", opt: true },
+        ],
+      },
     },
     filler: {
       model: "openai.ProjectRateLimit",
     },
+    mock: { route: "project-rate-limits" },
     attributes: [
       {
         name: "project_id",
@@ -1678,11 +1723,28 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["project_id", "id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id", "id"],
+      createParams: {
+        struct: "AdminOrganizationProjectRoleNewParams",
+        fields: [
+          { attribute: "name", field: "RoleName" },
+          { attribute: "permissions" },
+          { attribute: "description", opt: "zero" },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationProjectRoleUpdateParams",
+        fields: [
+          { attribute: "name", field: "RoleName", opt: "zero" },
+          { attribute: "permissions" },
+          { attribute: "description", opt: "zero" },
+        ],
+      },
     },
     importStateAttributes: ["project_id", "id"],
     filler: {
       model: "openai.Role",
     },
+    mock: { route: "project-roles" },
     attributes: [
       {
         name: "id",
@@ -1716,7 +1778,8 @@ export const RESOURCES: Array<Resource> = [
       {
         name: "permissions",
         type: "set",
-        description: "Permissions to grant to the role.",
+        description:
+          "Permissions to grant to the role, as listed by the `openai_permissions` data source. Permissions that no predefined role of the project grants fail the plan, if the project already exists.",
         computedOptionalRequired: "required",
         elementType: "string",
       },
@@ -1736,7 +1799,20 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["project_id", "id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id", "id"],
+      createParams: {
+        struct: "AdminOrganizationProjectServiceAccountNewParams",
+        fields: [
+          { attribute: "name" },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationProjectServiceAccountUpdateParams",
+        fields: [
+          { attribute: "name", opt: "zero" },
+        ],
+      },
     },
+    mock: { route: "project-service-accounts" },
     attributes: [
       {
         name: "project_id",
@@ -1807,11 +1883,26 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["project_id", "user_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id", "user_id"],
+      createParams: {
+        struct: "AdminOrganizationProjectUserNewParams",
+        fields: [
+          { attribute: "role" },
+          { attribute: "user_id", field: "UserID", opt: "zero" },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationProjectUserUpdateParams",
+        fields: [
+          { attribute: "role", opt: "zero" },
+        ],
+      },
     },
     importStateAttributes: ["project_id", "user_id"],
+    importStateAlternateSeparators: [":"],
     filler: {
       model: "openai.ProjectUser",
     },
+    mock: { route: "project-users" },
     attributes: [
       {
         name: "project_id",
@@ -1834,7 +1925,8 @@ export const RESOURCES: Array<Resource> = [
       {
         name: "role",
         type: "string",
-        description: "`owner` or `member`.",
+        description:
+          "`owner` or `member`. Roles that are not predefined roles of the project fail the plan, if the project already exists.",
         computedOptionalRequired: "required",
         validators: ['stringvalidator.OneOf("owner", "member")'],
       },
@@ -1843,17 +1935,27 @@ export const RESOURCES: Array<Resource> = [
   {
     name: "user_role",
     description:
-      "Modifies a user's role in the organization.\n\n**NOTE:** The new `openai_user_role_assignment` resource supports predefined roles like `owner` and `reader` as well as custom roles. This resource may be removed in a future release.",
+      "Modifies a user's role in the organization. Destroying the resource restores the role from before it was created, if known.\n\n**NOTE:** The new `openai_user_role_assignment` resource supports predefined roles like `owner` and `reader` as well as custom roles. This resource may be removed in a future release.",
     api: {
       method: "Admin.Organization.Users",
       createMethod: "Update",
       createRequestAttributes: ["user_id"],
       readMethod: "Get",
       readRequestAttributes: ["user_id"],
+      readModel: "OrganizationUser",
       updateMethod: "Update",
       updateRequestAttributes: ["user_id"],
+      deleteStrategy: "reset_to_defaults",
+      createParams: "updateParams",
+      updateParams: {
+        struct: "AdminOrganizationUserUpdateParams",
+        fields: [
+          { attribute: "role", opt: "zero" },
+        ],
+      },
     },
     importStateAttributes: ["user_id"],
+    mock: { route: "users" },
     attributes: [
       {
         name: "user_id",
@@ -1884,8 +1986,16 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["user_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["user_id", "role_id"],
+      createParams: "updateParams",
+      updateParams: {
+        struct: "AdminOrganizationUserRoleNewParams",
+        fields: [
+          { attribute: "role_id", field: "RoleID" },
+        ],
+      },
     },
     importStateAttributes: ["user_id", "role_id"],
+    mock: { route: "user-roles" },
     attributes: [
       {
         name: "user_id",
@@ -1914,8 +2024,28 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["id"],
+      createParams: {
+        struct: "AdminOrganizationGroupNewParams",
+        fields: [
+          { attribute: "name" },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationGroupUpdateParams",
+        fields: [
+          { attribute: "name" },
+        ],
+      },
     },
     importStateAttributes: ["id"],
+    sweeper: {
+      listParamsStruct: "AdminOrganizationGroupListParams",
+      dependencies: [
+        "openai_group_role_assignment",
+        "openai_project_group_role_assignment",
+      ],
+    },
+    mock: { route: "groups" },
     attributes: [
       {
         name: "name",
@@ -1949,8 +2079,15 @@ export const RESOURCES: Array<Resource> = [
       readRequestAttributes: ["group_id", "user_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["group_id", "user_id"],
+      createParams: {
+        struct: "AdminOrganizationGroupUserNewParams",
+        fields: [
+          { attribute: "user_id", field: "UserID" },
+        ],
+      },
     },
     importStateAttributes: ["group_id", "user_id"],
+    mock: { route: "group-users" },
     attributes: [
       {
         name: "group_id",
@@ -1980,8 +2117,15 @@ export const RESOURCES: Array<Resource> = [
       readRequestAttributes: ["group_id", "role_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["group_id", "role_id"],
+      createParams: {
+        struct: "AdminOrganizationGroupRoleNewParams",
+        fields: [
+          { attribute: "role_id", field: "RoleID" },
+        ],
+      },
     },
     importStateAttributes: ["group_id", "role_id"],
+    mock: { route: "group-roles" },
     attributes: [
       {
         name: "group_id",
@@ -2002,26 +2146,47 @@ export const RESOURCES: Array<Resource> = [
   },
   {
     name: "data_retention",
-    description: "Updates organization data retention controls.",
+    description:
+      "Updates organization data retention controls. Destroying the resource restores the retention type from before it was created, if known.",
     api: {
       method: "Admin.Organization.DataRetention",
       createMethod: "Update",
       readMethod: "Get",
       updateMethod: "Update",
+      deleteStrategy: "reset_to_defaults",
+      createParams: "updateParams",
+      updateParams: {
+        struct: "AdminOrganizationDataRetentionUpdateParams",
+        fields: [
+          {
+            attribute: "type",
+            field: "RetentionType",
+            enum: [
+              "AdminOrganizationDataRetentionUpdateParamsRetentionTypeZeroDataRetention",
+              "AdminOrganizationDataRetentionUpdateParamsRetentionTypeModifiedAbuseMonitoring",
+              "AdminOrganizationDataRetentionUpdateParamsRetentionTypeEnhancedZeroDataRetention",
+              "AdminOrganizationDataRetentionUpdateParamsRetentionTypeEnhancedModifiedAbuseMonitoring",
+            ],
+          },
+        ],
+      },
+    },
+    openapi: {
+      schema: "OrganizationDataRetention",
+      createRequest: "OrganizationDataRetentionUpdateRequest",
+      // The request names the attribute `retention_type`.
+      exclude: ["retention_type"],
     },
     filler: {
       model: "openai.OrganizationDataRetention",
     },
+    mock: { route: "data-retention" },
     attributes: [
       {
         name: "type",
-        type: "string",
         description:
           "The desired organization data retention type. Must be one of `zero_data_retention`, `enhanced_zero_data_retention`, `modified_abuse_monitoring`, or `enhanced_modified_abuse_monitoring`.",
         computedOptionalRequired: "required",
-        validators: [
-          'stringvalidator.OneOf("zero_data_retention", "modified_abuse_monitoring", "enhanced_zero_data_retention", "enhanced_modified_abuse_monitoring")',
-        ],
       },
     ],
   },
@@ -2034,50 +2199,43 @@ export const RESOURCES: Array<Resource> = [
       readMethod: "Get",
       updateMethod: "Update",
       deleteMethod: "Delete",
+      deleteStrategy: "api_delete",
+      createParams: "updateParams",
+      updateParams: {
+        struct: "AdminOrganizationSpendLimitUpdateParams",
+        fields: [
+          {
+            attribute: "currency",
+            enum: ["AdminOrganizationSpendLimitUpdateParamsCurrencyUsd"],
+          },
+          {
+            attribute: "interval",
+            enum: ["AdminOrganizationSpendLimitUpdateParamsIntervalMonth"],
+          },
+          { attribute: "threshold_amount" },
+        ],
+      },
+    },
+    openapi: {
+      schema: "OrganizationSpendLimit",
+      createRequest: "SpendLimitUpdateRequest",
     },
     filler: {
       model: "openai.OrganizationSpendLimit",
     },
+    mock: { route: "spend-limit" },
     attributes: [
-      {
-        name: "currency",
-        type: "string",
-        description:
-          "The currency for the threshold amount. Currently, only `USD` is supported.",
-        computedOptionalRequired: "required",
-        validators: ['stringvalidator.OneOf("USD")'],
-      },
-      {
-        name: "interval",
-        type: "string",
-        description:
-          "The time interval for evaluating spend against the threshold. Currently, only `month` is supported.",
-        computedOptionalRequired: "required",
-        validators: ['stringvalidator.OneOf("month")'],
-      },
+      { name: "currency" },
+      { name: "interval" },
       {
         name: "threshold_amount",
-        type: "int64",
-        description: "The hard spend limit amount, in cents.",
-        computedOptionalRequired: "required",
         validators: ["int64validator.AtLeast(1)"],
       },
       {
         name: "enforcement",
-        type: "single_nested",
-        description: "The current enforcement state of the hard spend limit.",
-        computedOptionalRequired: "computed",
         filler: {
           model: "openai.OrganizationSpendLimitEnforcement",
         },
-        attributes: [
-          {
-            name: "status",
-            type: "string",
-            description: "Whether the hard spend limit is currently enforcing.",
-            computedOptionalRequired: "computed",
-          },
-        ],
       },
     ],
   },
@@ -2094,11 +2252,34 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["project_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id"],
+      deleteStrategy: "api_delete",
+      createParams: "updateParams",
+      updateParams: {
+        struct: "AdminOrganizationProjectSpendLimitUpdateParams",
+        fields: [
+          {
+            attribute: "currency",
+            enum: ["AdminOrganizationProjectSpendLimitUpdateParamsCurrencyUsd"],
+          },
+          {
+            attribute: "interval",
+            enum: [
+              "AdminOrganizationProjectSpendLimitUpdateParamsIntervalMonth",
+            ],
+          },
+          { attribute: "threshold_amount" },
+        ],
+      },
     },
     importStateAttributes: ["project_id"],
+    openapi: {
+      schema: "ProjectSpendLimit",
+      createRequest: "SpendLimitUpdateRequest",
+    },
     filler: {
       model: "openai.ProjectSpendLimit",
     },
+    mock: { route: "project-spend-limit" },
     attributes: [
       {
         name: "project_id",
@@ -2109,45 +2290,17 @@ export const RESOURCES: Array<Resource> = [
         planModifiers: ["stringplanmodifier.RequiresReplace()"],
         filler: { skip: true },
       },
-      {
-        name: "currency",
-        type: "string",
-        description:
-          "The currency for the threshold amount. Currently, only `USD` is supported.",
-        computedOptionalRequired: "required",
-        validators: ['stringvalidator.OneOf("USD")'],
-      },
-      {
-        name: "interval",
-        type: "string",
-        description:
-          "The time interval for evaluating spend against the threshold. Currently, only `month` is supported.",
-        computedOptionalRequired: "required",
-        validators: ['stringvalidator.OneOf("month")'],
-      },
+      { name: "currency" },
+      { name: "interval" },
       {
         name: "threshold_amount",
-        type: "int64",
-        description: "The hard spend limit amount, in cents.",
-        computedOptionalRequired: "required",
         validators: ["int64validator.AtLeast(1)"],
       },
       {
         name: "enforcement",
-        type: "single_nested",
-        description: "The current enforcement state of the hard spend limit.",
-        computedOptionalRequired: "computed",
         filler: {
           model: "openai.ProjectSpendLimitEnforcement",
         },
-        attributes: [
-          {
-            name: "status",
-            type: "string",
-            description: "Whether the hard spend limit is currently enforcing.",
-            computedOptionalRequired: "computed",
-          },
-        ],
       },
     ],
   },
@@ -2164,11 +2317,27 @@ export const RESOURCES: Array<Resource> = [
       readRequestAttributes: ["project_id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id"],
+      deleteStrategy: "api_delete",
+      createParams: "updateParams",
+      updateParams: {
+        struct: "AdminOrganizationProjectModelPermissionUpdateParams",
+        fields: [
+          {
+            attribute: "mode",
+            enum: [
+              "AdminOrganizationProjectModelPermissionUpdateParamsModeAllowList",
+              "AdminOrganizationProjectModelPermissionUpdateParamsModeDenyList",
+            ],
+          },
+          { attribute: "model_ids", field: "ModelIDs" },
+        ],
+      },
     },
     importStateAttributes: ["project_id"],
     filler: {
       model: "openai.ProjectModelPermissions",
     },
+    mock: { route: "project-model-permissions" },
     attributes: [
       {
         name: "project_id",
@@ -2212,11 +2381,60 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["project_id", "id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["project_id", "id"],
+      createParams: {
+        struct: "AdminOrganizationProjectSpendAlertNewParams",
+        fields: [
+          {
+            attribute: "currency",
+            enum: ["AdminOrganizationProjectSpendAlertNewParamsCurrencyUsd"],
+          },
+          {
+            attribute: "interval",
+            enum: ["AdminOrganizationProjectSpendAlertNewParamsIntervalMonth"],
+          },
+          { attribute: "threshold_amount" },
+          {
+            attribute: "notification_channel",
+            struct: "AdminOrganizationProjectSpendAlertNewParamsNotificationChannel",
+            fields: [
+              { attribute: "recipients" },
+              { attribute: "subject_prefix", opt: true },
+            ],
+          },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationProjectSpendAlertUpdateParams",
+        fields: [
+          {
+            attribute: "currency",
+            enum: ["AdminOrganizationProjectSpendAlertUpdateParamsCurrencyUsd"],
+          },
+          {
+            attribute: "interval",
+            enum: [
+              "AdminOrganizationProjectSpendAlertUpdateParamsIntervalMonth",
+            ],
+          },
+          { attribute: "threshold_amount" },
+          {
+            attribute: "notification_channel",
+            struct: "AdminOrganizationProjectSpendAlertUpdateParamsNotificationChannel",
+            fields: [
+              { attribute: "recipients" },
+              { attribute: "subject_prefix", opt: true },
+            ],
+          },
+        ],
+      },
     },
     importStateAttributes: ["project_id", "id"],
     filler: {
       model: "openai.ProjectSpendAlert",
     },
+    // A project has at most one spend alert, and creating another replaces it,
+    // which the generated mock cannot express.
+    mock: { route: "project-spend-alerts" },
     attributes: [
       {
         name: "project_id",
@@ -2242,12 +2460,14 @@ export const RESOURCES: Array<Resource> = [
         type: "string",
         description: "The currency for the threshold amount (e.g. `USD`).",
         computedOptionalRequired: "required",
+        validators: ['stringvalidator.OneOf("USD")'],
       },
       {
         name: "interval",
         type: "string",
         description: "The interval for the spend alert (e.g. `month`).",
         computedOptionalRequired: "required",
+        validators: ['stringvalidator.OneOf("month")'],
       },
       {
         name: "notification_channel",
@@ -2303,11 +2523,60 @@ export const RESOURCES: Array<Resource> = [
       updateRequestAttributes: ["id"],
       deleteMethod: "Delete",
       deleteRequestAttributes: ["id"],
+      createParams: {
+        struct: "AdminOrganizationSpendAlertNewParams",
+        fields: [
+          {
+            attribute: "currency",
+            enum: ["AdminOrganizationSpendAlertNewParamsCurrencyUsd"],
+          },
+          {
+            attribute: "interval",
+            enum: ["AdminOrganizationSpendAlertNewParamsIntervalMonth"],
+          },
+          { attribute: "threshold_amount" },
+          {
+            attribute: "notification_channel",
+            struct: "AdminOrganizationSpendAlertNewParamsNotificationChannel",
+            fields: [
+              { attribute: "recipients" },
+              { attribute: "subject_prefix", opt: true },
+            ],
+          },
+        ],
+      },
+      updateParams: {
+        struct: "AdminOrganizationSpendAlertUpdateParams",
+        fields: [
+          {
+            attribute: "currency",
+            enum: ["AdminOrganizationSpendAlertUpdateParamsCurrencyUsd"],
+          },
+          {
+            attribute: "interval",
+            enum: ["AdminOrganizationSpendAlertUpdateParamsIntervalMonth"],
+          },
+          { attribute: "threshold_amount" },
+          {
+            attribute: "notification_channel",
+            struct: "AdminOrganizationSpendAlertUpdateParamsNotificationChannel",
+            fields: [
+              { attribute: "recipients" },
+              { attribute: "subject_prefix", opt: true },
+            ],
+          },
+        ],
+      },
     },
     importStateAttributes: ["id"],
     filler: {
       model: "openai.OrganizationSpendAlert",
     },
+    mock: {
+      path: "/organization/spend_alerts",
+      object: "organization.spend_alert",
+      idPrefix: "alert_",
+    },
     attributes: [
       {
         name: "id",
@@ -2324,12 +2593,14 @@ export const RESOURCES: Array<Resource> = [
         type: "string",
         description: "The currency for the threshold amount (e.g. `USD`).",
         computedOptionalRequired: "required",
+        validators: ['stringvalidator.OneOf("USD")'],
       },
       {
         name: "interval",
         type: "string",
         description: "The interval for the spend alert (e.g. `month`).",
         computedOptionalRequired: "required",
+        validators: ['stringvalidator.OneOf("month")'],
       },
       {
         name: "notification_channel",
@@ -0,0 +1,90 @@
+// Replaces openapi/admin.json with the component schemas bound in settings.ts,
+// and the schemas they reference, from the OpenAPI spec that the openai-go
+// version in go.mod was generated from.
+import { $ } from "bun";
+import type { SchemaObject } from "./openapi";
+import { RESOURCES } from "./settings";
+
+interface Spec {
+  openapi: string;
+  info: Record<string, unknown>;
+  paths: Record<string, unknown>;
+  components: { schemas: Record<string, SchemaObject> };
+}
+
+const moduleDir = (
+  await $`go list -m -f {{.Dir}} github.com/openai/openai-go/v3`.text()
+).trim();
+const stats = await Bun.file(`${moduleDir}/.stats.yml`).text();
+const specUrl = stats.match(/^openapi_spec_url:\s*(\S+)$/m)?.[1];
+if (!specUrl) {
+  throw new Error(`openapi_spec_url not found in ${moduleDir}/.stats.yml`);
+}
+
+console.log(`Downloading ${specUrl}...`);
+const response = await fetch(specUrl);
+if (!response.ok) {
+  throw new Error(`Failed to download ${specUrl}: ${response.status}`);
+}
+const spec = Bun.YAML.parse(await response.text()) as Spec;
+
+const names = new Set<string>();
+function add(name: string) {
+  if (names.has(name)) {
+    return;
+  }
+  const schema = spec.components.schemas[name];
+  if (!schema) {
+    throw new Error(`Schema ${name} not found in ${specUrl}`);
+  }
+  names.add(name);
+  collect(schema);
+}
+function collect(value: unknown) {
+  if (Array.isArray(value)) {
+    value.forEach(collect);
+  } else if (value && typeof value === "object") {
+    for (const [key, child] of Object.entries(value)) {
+      if (key === "$ref" && typeof child === "string") {
+        add(child.replace("#/components/schemas/", ""));
+      } else {
+        collect(child);
+      }
+    }
+  }
+}
+for (const resource of RESOURCES) {
+  if (resource.openapi) {
+    const { schema, createRequest, updateRequest } = resource.openapi;
+    for (const name of [schema, createRequest, updateRequest]) {
+      if (name) {
+        add(name);
+      }
+    }
+  }
+}
+
+const schemas = Object.fromEntries(
+  [...names].sort().map((name) => [name, spec.components.schemas[name]]),
+);
+
+const destination = new URL("./openapi/admin.json", import.meta.url);
+await Bun.write(
+  destination,
+  JSON.stringify(
+    {
+      openapi: spec.openapi,
+      info: {
+        title: "OpenAI Admin API",
+        description:
+          "The component schemas of the OpenAI API bound in settings.ts, and the schemas they reference. Refresh with `bun run update-openapi`.",
+        "x-source": specUrl,
+      },
+      components: { schemas },
+    },
+    null,
+    2,
+  ) + "\n",
+);
+await $`bun x prettier --write ${destination.pathname}`;
+console.log(`Wrote ${names.size} schemas to openapi/admin.json`);
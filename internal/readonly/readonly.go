@@ -0,0 +1,64 @@
+package readonly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/openai/openai-go/v3/option"
+)
+
+// ErrorCode is the error code reported for requests rejected in read-only
+// mode.
+const ErrorCode = "provider_read_only"
+
+// Message returns the message reported when action is refused in read-only
+// mode, e.g. "send POST /v1/organization/projects".
+func Message(action string) string {
+	return fmt.Sprintf("The provider is in read-only mode and refused to %s. Unset read_only or OPENAI_READ_ONLY to allow changes.", action)
+}
+
+// Middleware implements option.Middleware, rejecting every request that could
+// modify the organization before it is sent.
+//
+// The rejection is reported as a non-retryable 403 response rather than an
+// error, since the SDK retries transport errors.
+func Middleware(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return next(req)
+	}
+
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"message": Message(fmt.Sprintf("send %s %s", req.Method, req.URL.Path)),
+			"type":    "invalid_request_error",
+			"code":    ErrorCode,
+			"param":   nil,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", http.StatusForbidden, http.StatusText(http.StatusForbidden)),
+		StatusCode: http.StatusForbidden,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header: http.Header{
+			"Content-Type":   []string{"application/json"},
+			"X-Should-Retry": []string{"false"},
+		},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
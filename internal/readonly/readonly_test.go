@@ -0,0 +1,58 @@
+package readonly
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/openai/openai-go/v3"
+	"github.com/openai/openai-go/v3/option"
+)
+
+func TestMiddleware(t *testing.T) {
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"object": "organization.project", "id": "proj_abc", "name": "Test"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	client := openai.NewClient(
+		option.WithBaseURL(srv.URL),
+		option.WithAdminAPIKey("sk-admin-test"),
+		option.WithMaxRetries(3),
+		option.WithMiddleware(Middleware),
+	)
+
+	ctx := context.Background()
+
+	if _, err := client.Admin.Organization.Projects.Get(ctx, "proj_abc"); err != nil {
+		t.Fatalf("Expected no error, got %s", err)
+	}
+
+	_, err := client.Admin.Organization.Projects.New(ctx, openai.AdminOrganizationProjectNewParams{
+		Name: "Test",
+	})
+	apiErr, ok := errors.AsType[*openai.Error](err)
+	if !ok {
+		t.Fatalf("Expected *openai.Error, got %v", err)
+	}
+	if apiErr.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status %d, got %d", http.StatusForbidden, apiErr.StatusCode)
+	}
+	if apiErr.Code != ErrorCode {
+		t.Errorf("Expected code %s, got %s", ErrorCode, apiErr.Code)
+	}
+
+	if _, err := client.Admin.Organization.Projects.Archive(ctx, "proj_abc"); err == nil {
+		t.Error("Expected error, got none")
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("Expected 1 call to reach the server, got %d", got)
+	}
+}
@@ -0,0 +1,59 @@
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// DefaultConcurrency is the number of objects deleted at once when
+// Options.Concurrency is not set.
+const DefaultConcurrency = 4
+
+type Options struct {
+	// DryRun logs the objects that would be deleted without deleting them.
+	DryRun bool
+	// MinAge skips objects created less than MinAge ago, so that sweeping does
+	// not delete the fixtures of tests that are still running. Objects whose
+	// creation time is unknown, such as roles, role assignments and spend
+	// alerts, are skipped too, unless IncludeUnknownAge is set.
+	MinAge time.Duration
+	// IncludeUnknownAge sweeps the objects whose creation time is unknown
+	// despite MinAge, matching them by name only. They are logged with a
+	// warning, as they may belong to tests that are still running.
+	IncludeUnknownAge bool
+	// Concurrency is the maximum number of objects deleted at once.
+	Concurrency int
+}
+
+// OptionsFromEnv reads the options of the sweepers run by `go test -sweep`
+// from OPENAI_SWEEP_DRY_RUN, OPENAI_SWEEP_MIN_AGE,
+// OPENAI_SWEEP_INCLUDE_UNKNOWN_AGE and OPENAI_SWEEP_CONCURRENCY.
+func OptionsFromEnv() (Options, error) {
+	var opts Options
+	var err error
+
+	if v := os.Getenv("OPENAI_SWEEP_DRY_RUN"); v != "" {
+		if opts.DryRun, err = strconv.ParseBool(v); err != nil {
+			return opts, fmt.Errorf("invalid OPENAI_SWEEP_DRY_RUN: %w", err)
+		}
+	}
+	if v := os.Getenv("OPENAI_SWEEP_MIN_AGE"); v != "" {
+		if opts.MinAge, err = time.ParseDuration(v); err != nil {
+			return opts, fmt.Errorf("invalid OPENAI_SWEEP_MIN_AGE: %w", err)
+		}
+	}
+	if v := os.Getenv("OPENAI_SWEEP_INCLUDE_UNKNOWN_AGE"); v != "" {
+		if opts.IncludeUnknownAge, err = strconv.ParseBool(v); err != nil {
+			return opts, fmt.Errorf("invalid OPENAI_SWEEP_INCLUDE_UNKNOWN_AGE: %w", err)
+		}
+	}
+	if v := os.Getenv("OPENAI_SWEEP_CONCURRENCY"); v != "" {
+		if opts.Concurrency, err = strconv.Atoi(v); err != nil {
+			return opts, fmt.Errorf("invalid OPENAI_SWEEP_CONCURRENCY: %w", err)
+		}
+	}
+
+	return opts, nil
+}
@@ -2,7 +2,11 @@ package sweep
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +22,7 @@ type sweepResource struct {
 	factory    func() resource.Resource
 	client     *openai.Client
 	attributes map[string]any
+	createdAt  time.Time
 }
 
 func NewSweepResource(factory func() resource.Resource, client *openai.Client, attributes map[string]any) *sweepResource {
@@ -28,6 +33,34 @@ func NewSweepResource(factory func() resource.Resource, client *openai.Client, a
 	}
 }
 
+// WithCreatedAt sets the creation time of the object, as the Unix timestamp
+// reported by the API, which the minimum age filter of Sweep requires. A zero
+// timestamp, which the API returns when the time is not known, leaves the age
+// of the object unknown.
+func (sr *sweepResource) WithCreatedAt(createdAt int64) *sweepResource {
+	if createdAt == 0 {
+		sr.createdAt = time.Time{}
+		return sr
+	}
+	sr.createdAt = time.Unix(createdAt, 0)
+	return sr
+}
+
+func (sr *sweepResource) Description() string {
+	var metadataResp resource.MetadataResponse
+	sr.factory().Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "openai"}, &metadataResp)
+
+	parts := []string{metadataResp.TypeName}
+	for _, k := range slices.Sorted(maps.Keys(sr.attributes)) {
+		parts = append(parts, fmt.Sprintf("%s=%v", k, sr.attributes[k]))
+	}
+	return strings.Join(parts, " ")
+}
+
+func (sr *sweepResource) CreatedAt() time.Time {
+	return sr.createdAt
+}
+
 func (sr *sweepResource) Delete(ctx context.Context) error {
 	res := sr.factory()
 
@@ -56,7 +89,6 @@ func (sr *sweepResource) Delete(ctx context.Context) error {
 		}
 	}
 
-	log.Printf("[INFO] Deleting resource: %v", sr.attributes)
 	var deleteResp resource.DeleteResponse
 	res.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
 	if deleteResp.Diagnostics.HasError() {
@@ -3,19 +3,106 @@ package sweep
 import (
 	"context"
 	"errors"
-
-	"github.com/openai/openai-go/v3"
-	lop "github.com/samber/lo/parallel"
+	"fmt"
+	"log"
+	"sync"
+	"time"
 )
 
 type Sweepable interface {
+	// Description identifies the object in logs, e.g. "openai_project id=proj_abc".
+	Description() string
+	// CreatedAt returns when the object was created, or the zero time if the API
+	// does not report it.
+	CreatedAt() time.Time
 	Delete(ctx context.Context) error
 }
 
-func Sweep(ctx context.Context, sweepables []Sweepable) error {
-	return errors.Join(lop.Map(sweepables, func(sweepable Sweepable, _ int) error {
-		return sweepable.Delete(ctx)
-	})...)
+type Status string
+
+const (
+	StatusDeleted Status = "deleted"
+	StatusDryRun  Status = "dry_run"
+	StatusSkipped Status = "skipped"
+	StatusFailed  Status = "failed"
+)
+
+// Result is the outcome of sweeping an object.
+type Result struct {
+	Description string     `json:"description"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Status      Status     `json:"status"`
+	// Reason explains why the object was skipped or could not be deleted.
+	Reason string `json:"reason,omitempty"`
 }
 
-type SweeperFn func(ctx context.Context, client *openai.Client) ([]Sweepable, error)
+// Sweep deletes the sweepables, returning the outcome for each of them in
+// order. The returned error joins the errors of the failed deletions.
+func Sweep(ctx context.Context, sweepables []Sweepable, opts Options) ([]Result, error) {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	now := time.Now()
+	results := make([]Result, len(sweepables))
+	errs := make([]error, len(sweepables))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(concurrency, len(sweepables)) {
+		wg.Go(func() {
+			for i := range indexes {
+				results[i], errs[i] = sweep(ctx, sweepables[i], opts, now)
+			}
+		})
+	}
+	for i := range sweepables {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results, errors.Join(errs...)
+}
+
+func sweep(ctx context.Context, sweepable Sweepable, opts Options, now time.Time) (Result, error) {
+	result := Result{
+		Description: sweepable.Description(),
+	}
+
+	createdAt := sweepable.CreatedAt()
+	if !createdAt.IsZero() {
+		result.CreatedAt = &createdAt
+	}
+
+	if opts.MinAge > 0 {
+		if createdAt.IsZero() {
+			if !opts.IncludeUnknownAge {
+				result.Status, result.Reason = StatusSkipped, "creation time is unknown"
+				log.Printf("[INFO] Skipping %s: %s", result.Description, result.Reason)
+				return result, nil
+			}
+			log.Printf("[WARN] Sweeping %s by name only: creation time is unknown", result.Description)
+		} else if age := now.Sub(createdAt); age < opts.MinAge {
+			result.Status, result.Reason = StatusSkipped, fmt.Sprintf("created %s ago", age.Round(time.Second))
+			log.Printf("[INFO] Skipping %s: %s", result.Description, result.Reason)
+			return result, nil
+		}
+	}
+
+	if opts.DryRun {
+		result.Status = StatusDryRun
+		log.Printf("[INFO] Would delete %s", result.Description)
+		return result, nil
+	}
+
+	log.Printf("[INFO] Deleting %s", result.Description)
+	if err := sweepable.Delete(ctx); err != nil {
+		result.Status, result.Reason = StatusFailed, err.Error()
+		return result, fmt.Errorf("failed to delete %s: %w", result.Description, err)
+	}
+
+	result.Status = StatusDeleted
+	return result, nil
+}
@@ -0,0 +1,151 @@
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeSweepable struct {
+	name      string
+	createdAt time.Time
+	err       error
+
+	deleted *[]string
+	mu      *sync.Mutex
+}
+
+func (f fakeSweepable) Description() string  { return f.name }
+func (f fakeSweepable) CreatedAt() time.Time { return f.createdAt }
+
+func (f fakeSweepable) Delete(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	*f.deleted = append(*f.deleted, f.name)
+	return f.err
+}
+
+func TestSweep(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name         string
+		opts         Options
+		wantStatuses []Status
+		wantDeleted  []string
+		wantErr      string
+	}{
+		{
+			name:         "default",
+			wantStatuses: []Status{StatusDeleted, StatusDeleted, StatusDeleted, StatusFailed},
+			wantDeleted:  []string{"failing", "new", "old", "unknown"},
+			wantErr:      "failed to delete failing: boom",
+		},
+		{
+			name:         "dry run",
+			opts:         Options{DryRun: true},
+			wantStatuses: []Status{StatusDryRun, StatusDryRun, StatusDryRun, StatusDryRun},
+		},
+		{
+			name:         "min age",
+			opts:         Options{MinAge: time.Hour},
+			wantStatuses: []Status{StatusDeleted, StatusSkipped, StatusSkipped, StatusSkipped},
+			wantDeleted:  []string{"old"},
+		},
+		{
+			name:         "min age including unknown age",
+			opts:         Options{MinAge: time.Hour, IncludeUnknownAge: true},
+			wantStatuses: []Status{StatusDeleted, StatusSkipped, StatusDeleted, StatusSkipped},
+			wantDeleted:  []string{"old", "unknown"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var deleted []string
+			var mu sync.Mutex
+			newSweepable := func(name string, createdAt time.Time, err error) Sweepable {
+				return fakeSweepable{name: name, createdAt: createdAt, err: err, deleted: &deleted, mu: &mu}
+			}
+
+			results, err := Sweep(t.Context(), []Sweepable{
+				newSweepable("old", now.Add(-2*time.Hour), nil),
+				newSweepable("new", now.Add(-time.Minute), nil),
+				newSweepable("unknown", time.Time{}, nil),
+				newSweepable("failing", now.Add(-time.Minute), errors.New("boom")),
+			}, tc.opts)
+
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tc.wantErr {
+				t.Errorf("got error %q, want %q", gotErr, tc.wantErr)
+			}
+
+			var gotStatuses []Status
+			for _, result := range results {
+				gotStatuses = append(gotStatuses, result.Status)
+			}
+			if !slices.Equal(gotStatuses, tc.wantStatuses) {
+				t.Errorf("got statuses %v, want %v", gotStatuses, tc.wantStatuses)
+			}
+
+			slices.Sort(deleted)
+			if !slices.Equal(deleted, tc.wantDeleted) {
+				t.Errorf("got deleted %v, want %v", deleted, tc.wantDeleted)
+			}
+		})
+	}
+}
+
+type blockingSweepable struct {
+	name    string
+	running *atomic.Int32
+	peak    *atomic.Int32
+}
+
+func (b blockingSweepable) Description() string  { return b.name }
+func (b blockingSweepable) CreatedAt() time.Time { return time.Time{} }
+
+func (b blockingSweepable) Delete(ctx context.Context) error {
+	running := b.running.Add(1)
+	defer b.running.Add(-1)
+	for {
+		peak := b.peak.Load()
+		if running <= peak || b.peak.CompareAndSwap(peak, running) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+	return nil
+}
+
+func TestSweep_Concurrency(t *testing.T) {
+	var running, peak atomic.Int32
+
+	var sweepables []Sweepable
+	for i := range 20 {
+		sweepables = append(sweepables, blockingSweepable{name: fmt.Sprint(i), running: &running, peak: &peak})
+	}
+
+	if _, err := Sweep(t.Context(), sweepables, Options{Concurrency: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if got := peak.Load(); got > 3 {
+		t.Errorf("got %d concurrent deletions, want at most 3", got)
+	}
+}
+
+func TestSweepResource_WithCreatedAt(t *testing.T) {
+	if got := NewSweepResource(nil, nil, nil).WithCreatedAt(0).CreatedAt(); !got.IsZero() {
+		t.Errorf("got %s for a zero timestamp, want the zero time", got)
+	}
+	if got, want := NewSweepResource(nil, nil, nil).WithCreatedAt(1711471533).CreatedAt(), time.Unix(1711471533, 0); !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package sweep
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/openai/openai-go/v3"
+)
+
+// DefaultPrefixes are the name prefixes of the objects created by the
+// acceptance tests.
+var DefaultPrefixes = []string{"tf-"}
+
+// Matcher reports whether the name of an object marks it for sweeping.
+type Matcher func(name string) bool
+
+// PrefixMatcher returns a Matcher of the names starting with any of the
+// patterns. A pattern may hold the wildcards of path.Match, e.g. "tf-*-role".
+func PrefixMatcher(patterns []string) (Matcher, error) {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern+"*", ""); err != nil {
+			return nil, fmt.Errorf("invalid prefix pattern %q: %w", pattern, err)
+		}
+	}
+
+	return func(name string) bool {
+		return slices.ContainsFunc(patterns, func(pattern string) bool {
+			ok, _ := path.Match(pattern+"*", name)
+			return ok
+		})
+	}, nil
+}
+
+type SweeperFn func(ctx context.Context, client *openai.Client, match Matcher) ([]Sweepable, error)
+
+// Sweeper collects the objects of a resource type to sweep.
+type Sweeper struct {
+	// Name is the resource type, e.g. "openai_project".
+	Name string
+	// Dependencies are the sweepers to run first, as their objects must be
+	// deleted before the objects of this one.
+	Dependencies []string
+	F            SweeperFn
+}
+
+// Resolve returns the sweepers with the given names and their dependencies,
+// each after its dependencies. All sweepers are returned when names is empty.
+func Resolve(sweepers []Sweeper, names []string) ([]Sweeper, error) {
+	byName := make(map[string]Sweeper, len(sweepers))
+	for _, sweeper := range sweepers {
+		byName[sweeper.Name] = sweeper
+	}
+
+	if len(names) == 0 {
+		for _, sweeper := range sweepers {
+			names = append(names, sweeper.Name)
+		}
+	}
+
+	var resolved []Sweeper
+	visited := make(map[string]bool)
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		if slices.Contains(path, name) {
+			return fmt.Errorf("dependency cycle: %v", append(path, name))
+		}
+		if visited[name] {
+			return nil
+		}
+		sweeper, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("unknown sweeper %q", name)
+		}
+		for _, dependency := range sweeper.Dependencies {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		resolved = append(resolved, sweeper)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return resolved, nil
+}
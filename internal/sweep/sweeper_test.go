@@ -0,0 +1,98 @@
+package sweep
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPrefixMatcher(t *testing.T) {
+	match, err := PrefixMatcher([]string{"tf-", "ci-*-role"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, want := range map[string]bool{
+		"tf-project":      true,
+		"ci-123-role-abc": true,
+		"ci-123":          false,
+		"prod-tf-project": false,
+		"":                false,
+	} {
+		if got := match(name); got != want {
+			t.Errorf("match(%q) = %t, want %t", name, got, want)
+		}
+	}
+
+	if _, err := PrefixMatcher([]string{"tf-["}); err == nil {
+		t.Error("expected an error for an invalid pattern")
+	}
+}
+
+func TestResolve(t *testing.T) {
+	sweepers := []Sweeper{
+		{Name: "openai_project", Dependencies: []string{"openai_project_role"}},
+		{Name: "openai_group", Dependencies: []string{"openai_group_role_assignment"}},
+		{Name: "openai_project_role", Dependencies: []string{"openai_project_group_role_assignment"}},
+		{Name: "openai_group_role_assignment"},
+		{Name: "openai_project_group_role_assignment"},
+	}
+
+	testCases := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "all",
+			want: []string{
+				"openai_project_group_role_assignment",
+				"openai_project_role",
+				"openai_project",
+				"openai_group_role_assignment",
+				"openai_group",
+			},
+		},
+		{
+			name:  "selected",
+			names: []string{"openai_project_role"},
+			want:  []string{"openai_project_group_role_assignment", "openai_project_role"},
+		},
+		{
+			name:    "unknown",
+			names:   []string{"openai_unknown"},
+			wantErr: `unknown sweeper "openai_unknown"`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, err := Resolve(sweepers, tc.names)
+
+			var gotErr string
+			if err != nil {
+				gotErr = err.Error()
+			}
+			if gotErr != tc.wantErr {
+				t.Fatalf("got error %q, want %q", gotErr, tc.wantErr)
+			}
+
+			var got []string
+			for _, sweeper := range resolved {
+				got = append(got, sweeper.Name)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResolve_Cycle(t *testing.T) {
+	_, err := Resolve([]Sweeper{
+		{Name: "a", Dependencies: []string{"b"}},
+		{Name: "b", Dependencies: []string{"a"}},
+	}, nil)
+	if err == nil {
+		t.Error("expected a dependency cycle error")
+	}
+}
@@ -0,0 +1,31 @@
+// Code generated by providergen. Delete this line to edit the file by hand.
+
+package sweepers
+
+import (
+	"context"
+
+	"github.com/jianyuan/terraform-provider-openai/internal/provider"
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+	"github.com/openai/openai-go/v3"
+)
+
+func groups(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	params := openai.AdminOrganizationGroupListParams{
+		Limit: openai.Int(100),
+	}
+
+	iter := client.Admin.Organization.Groups.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		if match(item.Name) {
+			sweepables = append(sweepables, sweep.NewSweepResource(provider.NewGroupResource, client, map[string]any{
+				"id": item.ID,
+			}).WithCreatedAt(item.CreatedAt))
+		}
+	}
+
+	return sweepables, iter.Err()
+}
@@ -0,0 +1,191 @@
+package sweepers
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/jianyuan/terraform-provider-openai/internal/provider"
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+	"github.com/openai/openai-go/v3"
+)
+
+func adminApiKeys(adminKey func() string) sweep.SweeperFn {
+	return func(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+		keyInUse := adminKey()
+
+		params := openai.AdminOrganizationAdminAPIKeyListParams{
+			Limit: openai.Int(100),
+		}
+
+		var sweepables []sweep.Sweepable
+
+		iter := client.Admin.Organization.AdminAPIKeys.ListAutoPaging(ctx, params)
+		for iter.Next() {
+			item := iter.Current()
+			if match(item.Name) && !isAdminKeyInUse(keyInUse, item.RedactedValue) {
+				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewAdminApiKeyResource, client, map[string]any{
+					"id": item.ID,
+				}).WithCreatedAt(item.CreatedAt))
+			}
+		}
+
+		return sweepables, iter.Err()
+	}
+}
+
+// isAdminKeyInUse reports whether the redacted value of an admin API key, such
+// as "sk-admin...abcd", matches the key the client authenticates with.
+// Deleting that key would lock the sweepers out halfway through.
+func isAdminKeyInUse(adminKey, redactedValue string) bool {
+	suffix := redactedValue[strings.LastIndexAny(redactedValue, ".*")+1:]
+	return suffix != "" && strings.HasSuffix(adminKey, suffix)
+}
+
+func invites(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	params := openai.AdminOrganizationInviteListParams{
+		Limit: openai.Int(100),
+	}
+
+	var sweepables []sweep.Sweepable
+
+	iter := client.Admin.Organization.Invites.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		if isTestEmail(match, item.Email) {
+			sweepables = append(sweepables, sweep.NewSweepResource(provider.NewInviteResource, client, map[string]any{
+				"id": item.ID,
+			}).WithCreatedAt(item.CreatedAt))
+		}
+	}
+
+	return sweepables, iter.Err()
+}
+
+func spendAlerts(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	params := openai.AdminOrganizationSpendAlertListParams{
+		Limit: openai.Int(100),
+	}
+
+	var sweepables []sweep.Sweepable
+
+	iter := client.Admin.Organization.SpendAlerts.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		if isTestRecipients(match, item.NotificationChannel.Recipients) {
+			sweepables = append(sweepables, sweep.NewSweepResource(provider.NewSpendAlertResource, client, map[string]any{
+				"id": item.ID,
+			}))
+		}
+	}
+
+	return sweepables, iter.Err()
+}
+
+// groupRoleAssignments sweeps the assignments of matching roles. The API does
+// not report when a role was assigned, so their age is unknown.
+func groupRoleAssignments(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	params := openai.AdminOrganizationGroupListParams{
+		Limit: openai.Int(100),
+	}
+
+	var groupIds []string
+	iter := client.Admin.Organization.Groups.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		groupIds = append(groupIds, item.ID)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	var sweepables []sweep.Sweepable
+
+	for _, groupId := range groupIds {
+		log.Printf("[INFO] Listing role assignments for group %s", groupId)
+
+		params := openai.AdminOrganizationGroupRoleListParams{
+			Limit: openai.Int(100),
+		}
+
+		iter := client.Admin.Organization.Groups.Roles.ListAutoPaging(ctx, groupId, params)
+		for iter.Next() {
+			item := iter.Current()
+			if match(item.Name) {
+				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewGroupRoleAssignmentResource, client, map[string]any{
+					"group_id": groupId,
+					"role_id":  item.ID,
+				}))
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sweepables, nil
+}
+
+func organizationRoles(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	params := openai.AdminOrganizationRoleListParams{
+		Limit: openai.Int(100),
+	}
+
+	var sweepables []sweep.Sweepable
+
+	iter := client.Admin.Organization.Roles.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		if match(item.Name) {
+			sweepables = append(sweepables, sweep.NewSweepResource(provider.NewOrganizationRoleResource, client, map[string]any{
+				"id": item.ID,
+			}))
+		}
+	}
+
+	return sweepables, iter.Err()
+}
+
+// userRoleAssignments sweeps the assignments of matching roles. The API does
+// not report when a role was assigned, so their age is unknown.
+func userRoleAssignments(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	params := openai.AdminOrganizationUserListParams{
+		Limit: openai.Int(100),
+	}
+
+	var userIds []string
+	iter := client.Admin.Organization.Users.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		userIds = append(userIds, item.ID)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	var sweepables []sweep.Sweepable
+
+	for _, userId := range userIds {
+		log.Printf("[INFO] Listing role assignments for user %s", userId)
+
+		params := openai.AdminOrganizationUserRoleListParams{
+			Limit: openai.Int(100),
+		}
+
+		iter := client.Admin.Organization.Users.Roles.ListAutoPaging(ctx, userId, params)
+		for iter.Next() {
+			item := iter.Current()
+			if match(item.Name) {
+				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewUserRoleAssignmentResource, client, map[string]any{
+					"user_id": userId,
+					"role_id": item.ID,
+				}))
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sweepables, nil
+}
@@ -0,0 +1,46 @@
+package sweepers
+
+import (
+	"testing"
+
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+)
+
+func TestIsAdminKeyInUse(t *testing.T) {
+	const adminKey = "sk-admin-abcdef1234"
+
+	for redactedValue, want := range map[string]bool{
+		"sk-admin...1234":  true,
+		"sk-admin-***1234": true,
+		"sk-admin...5678":  false,
+		"sk-admin-***":     false,
+		"":                 false,
+	} {
+		if got := isAdminKeyInUse(adminKey, redactedValue); got != want {
+			t.Errorf("isAdminKeyInUse(%q) = %t, want %t", redactedValue, got, want)
+		}
+	}
+}
+
+func TestIsTestRecipients(t *testing.T) {
+	match, err := sweep.PrefixMatcher(sweep.DefaultPrefixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		recipients []string
+		want       bool
+	}{
+		{recipients: []string{"tf-a@example.com", "TF-B@EXAMPLE.COM"}, want: false},
+		{recipients: []string{"tf-a@example.com", "tf-b@EXAMPLE.COM"}, want: true},
+		{recipients: []string{"tf-a@example.com", "a@example.com"}, want: false},
+		{recipients: []string{"tf-a@example.org"}, want: false},
+		{recipients: nil, want: false},
+	}
+	for _, tc := range testCases {
+		if got := isTestRecipients(match, tc.recipients); got != tc.want {
+			t.Errorf("isTestRecipients(%q) = %t, want %t", tc.recipients, got, tc.want)
+		}
+	}
+}
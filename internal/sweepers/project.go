@@ -0,0 +1,233 @@
+package sweepers
+
+import (
+	"context"
+	"log"
+
+	"github.com/jianyuan/terraform-provider-openai/internal/provider"
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+	"github.com/openai/openai-go/v3"
+)
+
+func projects(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	params := openai.AdminOrganizationProjectListParams{
+		Limit: openai.Int(100),
+	}
+
+	var sweepables []sweep.Sweepable
+
+	iter := client.Admin.Organization.Projects.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		if match(item.Name) {
+			sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectResource, client, map[string]any{
+				"id": item.ID,
+			}).WithCreatedAt(item.CreatedAt))
+		}
+	}
+
+	return sweepables, iter.Err()
+}
+
+// projectGroupRoleAssignments sweeps the assignments of matching roles. The API
+// does not report when a role was assigned, so their age is unknown.
+func projectGroupRoleAssignments(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	projectIds, err := projectIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, projectId := range projectIds {
+		log.Printf("[INFO] Listing project groups for project %s", projectId)
+
+		params := openai.AdminOrganizationProjectGroupListParams{
+			Limit: openai.Int(100),
+		}
+
+		var groupIds []string
+		iter := client.Admin.Organization.Projects.Groups.ListAutoPaging(ctx, projectId, params)
+		for iter.Next() {
+			item := iter.Current()
+			groupIds = append(groupIds, item.GroupID)
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, groupId := range groupIds {
+			params := openai.AdminOrganizationProjectGroupRoleListParams{
+				Limit: openai.Int(100),
+			}
+
+			iter := client.Admin.Organization.Projects.Groups.Roles.ListAutoPaging(ctx, projectId, groupId, params)
+			for iter.Next() {
+				item := iter.Current()
+				if match(item.Name) {
+					sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectGroupRoleAssignmentResource, client, map[string]any{
+						"project_id": projectId,
+						"group_id":   groupId,
+						"role_id":    item.ID,
+					}))
+				}
+			}
+			if err := iter.Err(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sweepables, nil
+}
+
+func projectRoles(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	projectIds, err := projectIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, projectId := range projectIds {
+		log.Printf("[INFO] Listing project roles for project %s", projectId)
+
+		params := openai.AdminOrganizationProjectRoleListParams{
+			Limit: openai.Int(100),
+		}
+
+		iter := client.Admin.Organization.Projects.Roles.ListAutoPaging(ctx, projectId, params)
+		for iter.Next() {
+			item := iter.Current()
+			if match(item.Name) {
+				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectRoleResource, client, map[string]any{
+					"project_id": projectId,
+					"id":         item.ID,
+				}))
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sweepables, nil
+}
+
+func projectServiceAccounts(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	projectIds, err := projectIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, projectId := range projectIds {
+		log.Printf("[INFO] Listing project service accounts for project %s", projectId)
+
+		params := openai.AdminOrganizationProjectServiceAccountListParams{
+			Limit: openai.Int(100),
+		}
+
+		iter := client.Admin.Organization.Projects.ServiceAccounts.ListAutoPaging(ctx, projectId, params)
+		for iter.Next() {
+			item := iter.Current()
+			if match(item.Name) {
+				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectServiceAccountResource, client, map[string]any{
+					"project_id": projectId,
+					"id":         item.ID,
+				}).WithCreatedAt(item.CreatedAt))
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sweepables, nil
+}
+
+func projectSpendAlerts(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	projectIds, err := projectIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, projectId := range projectIds {
+		log.Printf("[INFO] Listing project spend alerts for project %s", projectId)
+
+		params := openai.AdminOrganizationProjectSpendAlertListParams{
+			Limit: openai.Int(100),
+		}
+
+		iter := client.Admin.Organization.Projects.SpendAlerts.ListAutoPaging(ctx, projectId, params)
+		for iter.Next() {
+			item := iter.Current()
+			if isTestRecipients(match, item.NotificationChannel.Recipients) {
+				sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectSpendAlertResource, client, map[string]any{
+					"project_id": projectId,
+					"id":         item.ID,
+				}))
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sweepables, nil
+}
+
+// projectUserRoleAssignments sweeps the assignments of matching roles. The API
+// does not report when a role was assigned, so their age is unknown.
+func projectUserRoleAssignments(ctx context.Context, client *openai.Client, match sweep.Matcher) ([]sweep.Sweepable, error) {
+	var sweepables []sweep.Sweepable
+
+	projectIds, err := projectIds(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, projectId := range projectIds {
+		log.Printf("[INFO] Listing project users for project %s", projectId)
+
+		params := openai.AdminOrganizationProjectUserListParams{
+			Limit: openai.Int(100),
+		}
+
+		var userIds []string
+		iter := client.Admin.Organization.Projects.Users.ListAutoPaging(ctx, projectId, params)
+		for iter.Next() {
+			item := iter.Current()
+			userIds = append(userIds, item.ID)
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, userId := range userIds {
+			params := openai.AdminOrganizationProjectUserRoleListParams{
+				Limit: openai.Int(100),
+			}
+
+			iter := client.Admin.Organization.Projects.Users.Roles.ListAutoPaging(ctx, projectId, userId, params)
+			for iter.Next() {
+				item := iter.Current()
+				if match(item.Name) {
+					sweepables = append(sweepables, sweep.NewSweepResource(provider.NewProjectUserRoleAssignmentResource, client, map[string]any{
+						"project_id": projectId,
+						"user_id":    userId,
+						"role_id":    item.ID,
+					}))
+				}
+			}
+			if err := iter.Err(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sweepables, nil
+}
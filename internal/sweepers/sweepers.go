@@ -0,0 +1,107 @@
+// Package sweepers defines the sweepers of every resource type, which delete
+// the objects left behind by failed acceptance test runs. They are run by
+// `go test -sweep` and by the openai-sweep command.
+//
+// Objects are matched by name. Invites and spend alerts have no name, so they
+// are matched by their email addresses instead, which must be at example.com
+// and start with the prefix, e.g. "tf-1234@example.com".
+package sweepers
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+	"github.com/openai/openai-go/v3"
+)
+
+// All returns the sweepers of every resource type. adminKey returns the key the
+// client authenticates with, which the admin API key sweeper never deletes. It
+// is called when the sweeper runs, as the tests only know the key by then.
+func All(adminKey func() string) []sweep.Sweeper {
+	return append([]sweep.Sweeper{
+		{
+			Name: "openai_admin_api_key",
+			F:    adminApiKeys(adminKey),
+		},
+		{
+			Name: "openai_invite",
+			F:    invites,
+		},
+		{
+			Name: "openai_spend_alert",
+			F:    spendAlerts,
+		},
+		{
+			Name: "openai_group_role_assignment",
+			F:    groupRoleAssignments,
+		},
+		{
+			Name:         "openai_organization_role",
+			Dependencies: []string{"openai_group_role_assignment", "openai_user_role_assignment"},
+			F:            organizationRoles,
+		},
+		{
+			Name: "openai_user_role_assignment",
+			F:    userRoleAssignments,
+		},
+		{
+			Name:         "openai_project",
+			Dependencies: []string{"openai_project_role", "openai_project_service_account", "openai_project_spend_alert"},
+			F:            projects,
+		},
+		{
+			Name: "openai_project_group_role_assignment",
+			F:    projectGroupRoleAssignments,
+		},
+		{
+			Name:         "openai_project_role",
+			Dependencies: []string{"openai_project_group_role_assignment", "openai_project_user_role_assignment"},
+			F:            projectRoles,
+		},
+		{
+			Name: "openai_project_service_account",
+			F:    projectServiceAccounts,
+		},
+		{
+			Name: "openai_project_spend_alert",
+			F:    projectSpendAlerts,
+		},
+		{
+			Name: "openai_project_user_role_assignment",
+			F:    projectUserRoleAssignments,
+		},
+	}, generatedSweepers()...)
+}
+
+// projectIds lists the active projects, whose children are swept by the
+// project scoped sweepers. Archived projects are read-only and are skipped.
+func projectIds(ctx context.Context, client *openai.Client) ([]string, error) {
+	params := openai.AdminOrganizationProjectListParams{
+		Limit: openai.Int(100),
+	}
+
+	var projectIds []string
+	iter := client.Admin.Organization.Projects.ListAutoPaging(ctx, params)
+	for iter.Next() {
+		item := iter.Current()
+		projectIds = append(projectIds, item.ID)
+	}
+
+	return projectIds, iter.Err()
+}
+
+// isTestEmail reports whether email was generated by an acceptance test. The
+// tests only ever use matching addresses at example.com.
+func isTestEmail(match sweep.Matcher, email string) bool {
+	return match(email) && strings.HasSuffix(strings.ToLower(email), "@example.com")
+}
+
+// isTestRecipients reports whether every recipient was generated by an
+// acceptance test.
+func isTestRecipients(match sweep.Matcher, recipients []string) bool {
+	return len(recipients) > 0 && !slices.ContainsFunc(recipients, func(recipient string) bool {
+		return !isTestEmail(match, recipient)
+	})
+}
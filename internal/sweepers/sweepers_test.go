@@ -0,0 +1,23 @@
+package sweepers
+
+import (
+	"testing"
+
+	"github.com/jianyuan/terraform-provider-openai/internal/sweep"
+)
+
+func TestAll(t *testing.T) {
+	all := All(func() string { return "" })
+
+	names := make(map[string]bool)
+	for _, sweeper := range all {
+		if names[sweeper.Name] {
+			t.Errorf("sweeper %s is registered twice", sweeper.Name)
+		}
+		names[sweeper.Name] = true
+	}
+
+	if _, err := sweep.Resolve(all, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,112 @@
+package tfutils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// CompositeID encodes and decodes IDs made of several named parts, such as
+// the project_id/user_id import ID of a project user. Parts are joined with
+// "/", and separators in a part are percent-encoded, so parts may contain
+// slashes.
+type CompositeID struct {
+	names      []string
+	separators []byte
+}
+
+// NewCompositeID returns a CompositeID with the given part names.
+func NewCompositeID(names ...string) CompositeID {
+	if len(names) == 0 {
+		panic("tfutils: a composite ID needs at least one part")
+	}
+	return CompositeID{names: names, separators: []byte{'/'}}
+}
+
+// WithAlternateSeparators returns a copy of the CompositeID that also parses
+// IDs joined with the given separators, e.g. project_id:user_id. Built IDs
+// always use "/".
+func (c CompositeID) WithAlternateSeparators(separators ...byte) CompositeID {
+	for _, separator := range separators {
+		if separator == '%' || separator >= 0x80 {
+			panic(fmt.Sprintf("tfutils: invalid composite ID separator %q", separator))
+		}
+	}
+	c.separators = append(append([]byte(nil), c.separators...), separators...)
+	return c
+}
+
+// Names returns the part names.
+func (c CompositeID) Names() []string {
+	return c.names
+}
+
+// Format returns the accepted formats of the ID, for error messages.
+func (c CompositeID) Format() string {
+	formats := make([]string, len(c.separators))
+	for i, separator := range c.separators {
+		formats[i] = strings.Join(c.names, string(separator))
+	}
+	return strings.Join(formats, " or ")
+}
+
+// Build joins the parts into an ID.
+func (c CompositeID) Build(parts ...string) string {
+	if len(parts) != len(c.names) {
+		panic(fmt.Sprintf("tfutils: %d parts given, expected %s", len(parts), c.Format()))
+	}
+	escaped := make([]string, len(parts))
+	for i, part := range parts {
+		escaped[i] = c.escape(part)
+	}
+	return strings.Join(escaped, "/")
+}
+
+// Parse splits the ID into its parts, in the order of the part names.
+func (c CompositeID) Parse(id string) ([]string, error) {
+	// The ID is split with the first separator that gives the expected number
+	// of parts, and errors are reported against "/".
+	parts := strings.Split(id, "/")
+	for _, separator := range c.separators[1:] {
+		if len(parts) == len(c.names) {
+			break
+		}
+		if alternate := strings.Split(id, string(separator)); len(alternate) == len(c.names) {
+			parts = alternate
+		}
+	}
+
+	if len(parts) < len(c.names) {
+		return nil, c.errorf(id, "missing %s", strings.Join(c.names[len(parts):], ", "))
+	}
+	if len(parts) > len(c.names) {
+		return nil, c.errorf(id, "got %d parts", len(parts))
+	}
+	for i, part := range parts {
+		if part == "" {
+			return nil, c.errorf(id, "%s is empty", c.names[i])
+		}
+		unescaped, err := url.PathUnescape(part)
+		if err != nil {
+			return nil, c.errorf(id, "%s: %s", c.names[i], err)
+		}
+		parts[i] = unescaped
+	}
+	return parts, nil
+}
+
+func (c CompositeID) escape(part string) string {
+	var b strings.Builder
+	for i := range len(part) {
+		if part[i] == '%' || strings.IndexByte(string(c.separators), part[i]) >= 0 {
+			fmt.Fprintf(&b, "%%%02X", part[i])
+		} else {
+			b.WriteByte(part[i])
+		}
+	}
+	return b.String()
+}
+
+func (c CompositeID) errorf(id, format string, args ...any) error {
+	return fmt.Errorf("unexpected format of ID (%s): %s, expected %s", id, fmt.Sprintf(format, args...), c.Format())
+}
@@ -0,0 +1,89 @@
+package tfutils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompositeID_Build(t *testing.T) {
+	id := NewCompositeID("project_id", "role_id").WithAlternateSeparators(':')
+
+	testCases := []struct {
+		parts []string
+		want  string
+	}{
+		{parts: []string{"proj_abc", "role_abc"}, want: "proj_abc/role_abc"},
+		{parts: []string{"proj_abc", "team/admin"}, want: "proj_abc/team%2Fadmin"},
+		{parts: []string{"proj_abc", "a:b%c"}, want: "proj_abc/a%3Ab%25c"},
+	}
+	for _, tc := range testCases {
+		if got := id.Build(tc.parts...); got != tc.want {
+			t.Errorf("Build(%q) = %q, want %q", tc.parts, got, tc.want)
+		}
+	}
+}
+
+func TestCompositeID_Parse(t *testing.T) {
+	id := NewCompositeID("project_id", "user_id", "role_id").WithAlternateSeparators(':')
+
+	testCases := []struct {
+		name    string
+		id      string
+		want    []string
+		wantErr string
+	}{
+		{name: "slash", id: "proj_abc/user_abc/role_abc", want: []string{"proj_abc", "user_abc", "role_abc"}},
+		{name: "alternate", id: "proj_abc:user_abc:role_abc", want: []string{"proj_abc", "user_abc", "role_abc"}},
+		{name: "escaped", id: "proj_abc/user_abc/team%2Fadmin", want: []string{"proj_abc", "user_abc", "team/admin"}},
+		{
+			name:    "missing",
+			id:      "proj_abc/user_abc",
+			wantErr: "unexpected format of ID (proj_abc/user_abc): missing role_id, expected project_id/user_id/role_id or project_id:user_id:role_id",
+		},
+		{
+			name:    "too many",
+			id:      "proj_abc/user_abc/team/admin",
+			wantErr: "unexpected format of ID (proj_abc/user_abc/team/admin): got 4 parts, expected project_id/user_id/role_id or project_id:user_id:role_id",
+		},
+		{
+			name:    "empty",
+			id:      "proj_abc//role_abc",
+			wantErr: "unexpected format of ID (proj_abc//role_abc): user_id is empty, expected project_id/user_id/role_id or project_id:user_id:role_id",
+		},
+		{
+			name:    "invalid escape",
+			id:      "proj_abc/user_abc/role%zz",
+			wantErr: `unexpected format of ID (proj_abc/user_abc/role%zz): role_id: invalid URL escape "%zz", expected project_id/user_id/role_id or project_id:user_id:role_id`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := id.Parse(tc.id)
+			if tc.wantErr != "" {
+				if err == nil || err.Error() != tc.wantErr {
+					t.Fatalf("Parse error = %v, want %s", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("Parse = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCompositeID_RoundTrip(t *testing.T) {
+	id := NewCompositeID("group_id", "role_id")
+	parts := []string{"group_abc", "org/role:100%"}
+
+	got, err := id.Parse(id.Build(parts...))
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !slices.Equal(got, parts) {
+		t.Errorf("Parse(Build(%q)) = %q", parts, got)
+	}
+}